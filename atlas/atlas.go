@@ -0,0 +1,118 @@
+// Package atlas packs many small images into one, so that the game uploads a
+// single texture and draws all its sprites from it. The index tells the area
+// of each image in the atlas by its name. Areas can also be reserved for the
+// images which the game draws at run time, such as pattern tiles.
+package atlas
+
+import (
+	"errors"
+	"image"
+	"image/draw"
+	"sort"
+)
+
+// Padding is the number of transparent pixels around each area, which keeps
+// the neighbors from bleeding in when an image is scaled.
+const Padding = 1
+
+// Rect is the area of an image in the atlas.
+type Rect struct {
+	X int `json:"x"`
+	Y int `json:"y"`
+	W int `json:"w"`
+	H int `json:"h"`
+}
+
+// Rectangle returns r as an image.Rectangle.
+func (r Rect) Rectangle() image.Rectangle {
+	return image.Rect(r.X, r.Y, r.X+r.W, r.Y+r.H)
+}
+
+// Index is the table of contents of an atlas, which is saved as JSON next to
+// the image.
+type Index struct {
+	Width  int             `json:"width"`
+	Height int             `json:"height"`
+	Images map[string]Rect `json:"images"`
+}
+
+type entry struct {
+	name string
+	size image.Point
+	img  image.Image
+}
+
+// Pack packs imgs and the empty areas of the sizes in reserved into an atlas
+// by their names. The areas are placed in rows from the tallest, so the same
+// input always gives the same atlas.
+func Pack(imgs map[string]image.Image, reserved map[string]image.Point) (*image.NRGBA, *Index, error) {
+	var entries []entry
+	for name, img := range imgs {
+		entries = append(entries, entry{name: name, size: img.Bounds().Size(), img: img})
+	}
+	for name, size := range reserved {
+		if _, ok := imgs[name]; ok {
+			return nil, nil, errors.New("atlas: duplicated name " + name)
+		}
+		entries = append(entries, entry{name: name, size: size})
+	}
+	if len(entries) == 0 {
+		return nil, nil, errors.New("atlas: no images")
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].size.Y != entries[j].size.Y {
+			return entries[i].size.Y > entries[j].size.Y
+		}
+		return entries[i].name < entries[j].name
+	})
+
+	width := atlasWidth(entries)
+	idx := &Index{
+		Width:  width,
+		Images: map[string]Rect{},
+	}
+	x, y, rowH := 0, 0, 0
+	for _, e := range entries {
+		w := e.size.X + 2*Padding
+		h := e.size.Y + 2*Padding
+		if x+w > width {
+			x = 0
+			y += rowH
+			rowH = 0
+		}
+		idx.Images[e.name] = Rect{X: x + Padding, Y: y + Padding, W: e.size.X, H: e.size.Y}
+		x += w
+		if h > rowH {
+			rowH = h
+		}
+	}
+	idx.Height = y + rowH
+
+	dst := image.NewNRGBA(image.Rect(0, 0, idx.Width, idx.Height))
+	for _, e := range entries {
+		if e.img == nil {
+			continue
+		}
+		r := idx.Images[e.name].Rectangle()
+		draw.Draw(dst, r, e.img, e.img.Bounds().Min, draw.Src)
+	}
+	return dst, idx, nil
+}
+
+// atlasWidth returns the smallest power of two which fits the widest entry and
+// makes the atlas about square.
+func atlasWidth(entries []entry) int {
+	area, maxW := 0, 0
+	for _, e := range entries {
+		w := e.size.X + 2*Padding
+		area += w * (e.size.Y + 2*Padding)
+		if w > maxW {
+			maxW = w
+		}
+	}
+	width := 1
+	for width < maxW || width*width < area {
+		width *= 2
+	}
+	return width
+}
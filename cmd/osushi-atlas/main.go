@@ -0,0 +1,110 @@
+// Command osushi-atlas packs the PNG images in a directory into a texture
+// atlas. It writes atlas.png and its index atlas.json, where the images are
+// named by their file names without the extension.
+//
+//	osushi-atlas -src images -out atlas -reserve mountain=512x512
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"image"
+	"image/png"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hiroebe/osushi/atlas"
+)
+
+var (
+	srcDir  = flag.String("src", "images", "directory of the PNG images")
+	outDir  = flag.String("out", "atlas", "directory to write the atlas to")
+	reserve = flag.String("reserve", "", "comma-separated areas drawn at run time, e.g. tile=128x128")
+)
+
+func main() {
+	flag.Parse()
+
+	imgs, err := readImages(*srcDir)
+	if err != nil {
+		log.Fatal(err)
+	}
+	reserved, err := parseReserved(*reserve)
+	if err != nil {
+		log.Fatal(err)
+	}
+	img, idx, err := atlas.Pack(imgs, reserved)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.MkdirAll(*outDir, 0755); err != nil {
+		log.Fatal(err)
+	}
+	if err := writePNG(filepath.Join(*outDir, "atlas.png"), img); err != nil {
+		log.Fatal(err)
+	}
+	data, err := json.MarshalIndent(idx, "", "  ")
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(*outDir, "atlas.json"), append(data, '\n'), 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func readImages(dir string) (map[string]image.Image, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.png"))
+	if err != nil {
+		return nil, err
+	}
+	imgs := map[string]image.Image{}
+	for _, file := range files {
+		f, err := os.Open(file)
+		if err != nil {
+			return nil, err
+		}
+		img, err := png.Decode(f)
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode %s: %v", file, err)
+		}
+		imgs[strings.TrimSuffix(filepath.Base(file), ".png")] = img
+	}
+	return imgs, nil
+}
+
+// parseReserved parses areas in the form of "name=WxH,...".
+func parseReserved(s string) (map[string]image.Point, error) {
+	reserved := map[string]image.Point{}
+	if s == "" {
+		return reserved, nil
+	}
+	for _, item := range strings.Split(s, ",") {
+		var size image.Point
+		i := strings.IndexByte(item, '=')
+		if i < 0 {
+			return nil, fmt.Errorf("invalid area %q", item)
+		}
+		if _, err := fmt.Sscanf(item[i+1:], "%dx%d", &size.X, &size.Y); err != nil || size.X <= 0 || size.Y <= 0 {
+			return nil, fmt.Errorf("invalid area %q", item)
+		}
+		reserved[item[:i]] = size
+	}
+	return reserved, nil
+}
+
+func writePNG(name string, img image.Image) error {
+	f, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+	if err := png.Encode(f, img); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
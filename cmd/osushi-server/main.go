@@ -0,0 +1,112 @@
+// Command osushi-server is a server of the online leaderboard. It accepts a
+// score only if its replay reproduces the score with the game's physics.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/hiroebe/osushi/leaderboard"
+)
+
+const (
+	defaultLimit = 10
+	maxLimit     = 100
+	// maxBodySize is large enough for a replay of an hour-long run.
+	maxBodySize = 8 << 20
+)
+
+var (
+	addr   = flag.String("addr", ":8080", "address to listen on")
+	dbPath = flag.String("db", "osushi-scores.json", "path to the file storing scores")
+)
+
+type server struct {
+	store *store
+}
+
+func (s *server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// The wasm build of the game is served from another origin.
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	w.Header().Set("Access-Control-Allow-Methods", "GET, POST, OPTIONS")
+	w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
+
+	if r.URL.Path != "/scores" {
+		http.NotFound(w, r)
+		return
+	}
+	switch r.Method {
+	case http.MethodOptions:
+		w.WriteHeader(http.StatusNoContent)
+	case http.MethodGet:
+		s.getScores(w, r)
+	case http.MethodPost:
+		s.postScore(w, r)
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func (s *server) getScores(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	limit := defaultLimit
+	if l := q.Get("limit"); l != "" {
+		n, err := strconv.Atoi(l)
+		if err != nil || n <= 0 {
+			http.Error(w, "invalid limit", http.StatusBadRequest)
+			return
+		}
+		limit = n
+	}
+	if limit > maxLimit {
+		limit = maxLimit
+	}
+	scores := s.store.Top(q.Get("metric"), q.Get("mode"), q.Get("date"), limit)
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(scores); err != nil {
+		log.Println(err)
+	}
+}
+
+func (s *server) postScore(w http.ResponseWriter, r *http.Request) {
+	var score leaderboard.Score
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBodySize)).Decode(&score); err != nil {
+		http.Error(w, "invalid score", http.StatusBadRequest)
+		return
+	}
+	if err := verify(&score); err != nil {
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+	hash, err := replayHash(score.Replay)
+	if err != nil {
+		log.Println(err)
+		http.Error(w, "failed to store the score", http.StatusInternalServerError)
+		return
+	}
+	err = s.store.Add(score, hash)
+	if err == errDuplicate {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+	if err != nil {
+		log.Println(err)
+		http.Error(w, "failed to store the score", http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusCreated)
+}
+
+func main() {
+	flag.Parse()
+
+	st, err := openStore(*dbPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("listening on %s", *addr)
+	log.Fatal(http.ListenAndServe(*addr, &server{store: st}))
+}
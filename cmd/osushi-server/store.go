@@ -0,0 +1,134 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/hiroebe/osushi/leaderboard"
+	"github.com/hiroebe/osushi/physics"
+)
+
+// storeSize is the number of the scores kept for each metric and mode, and
+// for each date in the daily challenge.
+const storeSize = 100
+
+// errDuplicate is returned when the same score of the same run is submitted
+// again.
+var errDuplicate = errors.New("duplicate score")
+
+// entry is a stored score. The replay is dropped, but its hash is kept to find
+// the resubmissions of a run.
+type entry struct {
+	leaderboard.Score
+	ReplayHash string `json:"replayHash,omitempty"`
+}
+
+// replayHash returns the hash of the JSON of r.
+func replayHash(r *physics.Replay) (string, error) {
+	data, err := json.Marshal(r)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// store keeps the best scores in memory and writes all of them to a JSON file
+// on every change.
+type store struct {
+	path string
+
+	m      sync.Mutex
+	scores map[string][]entry
+}
+
+func storeKey(metric, mode, date string) string {
+	if mode == leaderboard.ModeDaily {
+		return metric + "/" + mode + "/" + date
+	}
+	return metric + "/" + mode
+}
+
+func openStore(path string) (*store, error) {
+	s := &store{
+		path:   path,
+		scores: map[string][]entry{},
+	}
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &s.scores); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Add stores score, whose replay has hash. It returns errDuplicate if the
+// score of the same run is already stored by the same name.
+func (s *store) Add(score leaderboard.Score, hash string) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	key := storeKey(score.Metric, score.Mode, score.Date)
+	scores := s.scores[key]
+	for _, e := range scores {
+		if e.Seed == score.Seed && e.Date == score.Date && e.Name == score.Name &&
+			e.Score.Score == score.Score && e.ReplayHash == hash {
+			return errDuplicate
+		}
+	}
+	// Older scores win ties.
+	i := sort.Search(len(scores), func(i int) bool {
+		return scores[i].Score.Score < score.Score
+	})
+	if i >= storeSize {
+		return nil
+	}
+	score.Replay = nil
+	scores = append(scores, entry{})
+	copy(scores[i+1:], scores[i:])
+	scores[i] = entry{Score: score, ReplayHash: hash}
+	if len(scores) > storeSize {
+		scores = scores[:storeSize]
+	}
+	s.scores[key] = scores
+	return s.save()
+}
+
+func (s *store) save() error {
+	data, err := json.Marshal(s.scores)
+	if err != nil {
+		return err
+	}
+	// Write to another file first so that a crash doesn't break the store.
+	tmp := s.path + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.path)
+}
+
+func (s *store) Top(metric, mode, date string, limit int) []leaderboard.Score {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	entries := s.scores[storeKey(metric, mode, date)]
+	if len(entries) > limit {
+		entries = entries[:limit]
+	}
+	scores := make([]leaderboard.Score, len(entries))
+	for i, e := range entries {
+		scores[i] = e.Score
+	}
+	return scores
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hiroebe/osushi/leaderboard"
+	"github.com/hiroebe/osushi/physics"
+)
+
+func TestStoreRejectsDuplicates(t *testing.T) {
+	dir, err := ioutil.TempDir("", "osushi-server")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	st, err := openStore(filepath.Join(dir, "scores.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, _, distance := run(42)
+	hash, err := replayHash(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := newScore(leaderboard.MetricDistance, distance, r)
+	if err := st.Add(*s, hash); err != nil {
+		t.Fatal(err)
+	}
+	if err := st.Add(*s, hash); err != errDuplicate {
+		t.Errorf("Add of the same score = %v, want errDuplicate", err)
+	}
+
+	// The same score of another run or by another name is not a duplicate.
+	other := physics.NewReplay(42)
+	otherHash, err := replayHash(other)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := st.Add(*s, otherHash); err != nil {
+		t.Errorf("Add of another run: %v", err)
+	}
+	s.Name = "BBB"
+	if err := st.Add(*s, hash); err != nil {
+		t.Errorf("Add by another name: %v", err)
+	}
+
+	// The duplicates are found after reopening the store.
+	st, err = openStore(filepath.Join(dir, "scores.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := st.Add(*s, hash); err != errDuplicate {
+		t.Errorf("Add of the same score after reopening = %v, want errDuplicate", err)
+	}
+	top := st.Top(leaderboard.MetricDistance, leaderboard.ModeNormal, "", 10)
+	if len(top) != 3 {
+		t.Fatalf("Top returned %d scores, want 3", len(top))
+	}
+	for _, sc := range top {
+		if sc.Replay != nil {
+			t.Error("Top returned a replay")
+		}
+	}
+}
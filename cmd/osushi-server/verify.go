@@ -0,0 +1,98 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/hiroebe/osushi/leaderboard"
+	"github.com/hiroebe/osushi/physics"
+)
+
+const (
+	// maxReplayTicks is an hour at 60 ticks per second.
+	maxReplayTicks = 60 * 60 * 60
+	maxNameLen     = 3
+	// tolerance absorbs the differences of floating point arithmetic among
+	// architectures, e.g. fused multiply-add on arm64.
+	tolerance = 1
+)
+
+func validName(name string) bool {
+	if len(name) == 0 || len(name) > maxNameLen {
+		return false
+	}
+	for _, c := range name {
+		if !('A' <= c && c <= 'Z' || '0' <= c && c <= '9') {
+			return false
+		}
+	}
+	return true
+}
+
+// verify re-simulates the replay of s and checks that it achieves the score.
+func verify(s *leaderboard.Score) error {
+	if !validName(s.Name) {
+		return errors.New("invalid name")
+	}
+	if err := verifyMode(s, time.Now()); err != nil {
+		return err
+	}
+	if s.Replay == nil {
+		return errors.New("replay is required")
+	}
+	if s.Replay.Seed != s.Seed {
+		return errors.New("seed of the replay doesn't match")
+	}
+	res, err := physics.Simulate(s.Replay, maxReplayTicks)
+	if err != nil {
+		return err
+	}
+
+	matches := func(v float64) bool {
+		return math.Abs(float64(int(v)-s.Score)) <= tolerance
+	}
+	switch s.Metric {
+	case leaderboard.MetricHeight:
+		for _, j := range res.Jumps {
+			if matches(j.Height) {
+				return nil
+			}
+		}
+	case leaderboard.MetricLength:
+		for _, j := range res.Jumps {
+			if matches(j.Length) {
+				return nil
+			}
+		}
+	case leaderboard.MetricDistance:
+		if matches(res.Distance) {
+			return nil
+		}
+	default:
+		return fmt.Errorf("unknown metric %q", s.Metric)
+	}
+	return errors.New("replay doesn't reproduce the score")
+}
+
+// verifyMode checks that a daily score is played on the terrain of the
+// current date in UTC, so that the past challenges are closed.
+func verifyMode(s *leaderboard.Score, now time.Time) error {
+	switch s.Mode {
+	case leaderboard.ModeNormal:
+		return nil
+	case leaderboard.ModeDaily:
+		if _, err := time.Parse(leaderboard.DateLayout, s.Date); err != nil {
+			return fmt.Errorf("invalid date %q", s.Date)
+		}
+		if s.Date != leaderboard.DailyDate(now) {
+			return fmt.Errorf("daily challenge of %q is not open", s.Date)
+		}
+		if s.Seed != leaderboard.DailySeed(s.Date) {
+			return errors.New("seed doesn't match the date")
+		}
+		return nil
+	}
+	return fmt.Errorf("unknown mode %q", s.Mode)
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hiroebe/osushi/leaderboard"
+	"github.com/hiroebe/osushi/physics"
+)
+
+// run plays a run on seed with a jump every two seconds as the client does,
+// and returns its replay, the highest jump and the distance.
+func run(seed int64) (r *physics.Replay, height, distance float64) {
+	r = physics.NewReplay(seed)
+	t := physics.NewTerrain(seed)
+	b := &physics.Body{}
+	for tick := 0; tick < 60*30; tick++ {
+		in := physics.Input{Jump: tick%120 == 60}
+		r.Record(in)
+		gy, grad := t.At(b.X)
+		b.Step(in, gy, grad)
+		if b.Landed && b.LastJump.Height > height {
+			height = b.LastJump.Height
+		}
+		t.Trim(b.X - 100)
+		t.Extend(b.X + 2000)
+	}
+	return r, height, b.X
+}
+
+func newScore(metric string, score float64, r *physics.Replay) *leaderboard.Score {
+	return &leaderboard.Score{
+		Name:   "AAA",
+		Metric: metric,
+		Score:  int(score),
+		Seed:   r.Seed,
+		Mode:   leaderboard.ModeNormal,
+		Replay: r,
+	}
+}
+
+func TestVerify(t *testing.T) {
+	r, height, distance := run(42)
+	if height == 0 {
+		t.Fatal("the run has no jumps")
+	}
+	if err := verify(newScore(leaderboard.MetricHeight, height, r)); err != nil {
+		t.Errorf("verify of the height: %v", err)
+	}
+	if err := verify(newScore(leaderboard.MetricDistance, distance, r)); err != nil {
+		t.Errorf("verify of the distance: %v", err)
+	}
+}
+
+func TestVerifyRejectsTampering(t *testing.T) {
+	// The distance is checked since the first mountain, which the highest
+	// jump may be from, is the same on every seed.
+	r, _, distance := run(42)
+	tests := []struct {
+		name   string
+		tamper func(s *leaderboard.Score)
+	}{
+		{"score", func(s *leaderboard.Score) { s.Score += 100 }},
+		{"metric", func(s *leaderboard.Score) { s.Metric = "speed" }},
+		{"name", func(s *leaderboard.Score) { s.Name = "a" }},
+		{"seed", func(s *leaderboard.Score) { s.Seed++ }},
+		{"replay seed", func(s *leaderboard.Score) {
+			s.Seed++
+			s.Replay.Seed++
+		}},
+		{"replay frames", func(s *leaderboard.Score) {
+			for i := range s.Replay.Frames {
+				s.Replay.Frames[i].Jump = false
+			}
+		}},
+		{"no replay", func(s *leaderboard.Score) { s.Replay = nil }},
+	}
+	for _, tt := range tests {
+		s := newScore(leaderboard.MetricDistance, distance, r.Copy())
+		tt.tamper(s)
+		if err := verify(s); err == nil {
+			t.Errorf("verify accepted a tampered %s", tt.name)
+		}
+	}
+}
+
+func TestVerifyMode(t *testing.T) {
+	now := time.Date(2020, 1, 2, 12, 0, 0, 0, time.UTC)
+	daily := func(date string) *leaderboard.Score {
+		return &leaderboard.Score{Mode: leaderboard.ModeDaily, Date: date, Seed: leaderboard.DailySeed(date)}
+	}
+	if err := verifyMode(daily("2020-01-02"), now); err != nil {
+		t.Errorf("verifyMode of today: %v", err)
+	}
+	if err := verifyMode(daily("2020-01-03"), now); err == nil {
+		t.Error("verifyMode accepted a future date")
+	}
+	if err := verifyMode(daily("2020-01-01"), now); err == nil {
+		t.Error("verifyMode accepted a past date")
+	}
+	// The date is in UTC wherever the server is.
+	tokyo := time.FixedZone("JST", 9*60*60)
+	if err := verifyMode(daily("2020-01-02"), now.Add(13*time.Hour).In(tokyo)); err == nil {
+		t.Error("verifyMode accepted yesterday in UTC")
+	}
+	if err := verifyMode(daily("2020/01/02"), now); err == nil {
+		t.Error("verifyMode accepted an invalid date")
+	}
+	s := daily("2020-01-02")
+	s.Seed++
+	if err := verifyMode(s, now); err == nil {
+		t.Error("verifyMode accepted a seed of another date")
+	}
+	if err := verifyMode(&leaderboard.Score{Mode: "weekly"}, now); err == nil {
+		t.Error("verifyMode accepted an unknown mode")
+	}
+}
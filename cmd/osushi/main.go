@@ -1,17 +1,57 @@
 package main
 
 import (
+	"flag"
 	"log"
 
 	"github.com/hajimehoshi/ebiten"
 	"github.com/hiroebe/osushi/game"
 )
 
+// defaultLeaderboardURL is the leaderboard used without the flag. It can be
+// set at build time with -ldflags "-X main.defaultLeaderboardURL=...".
+var defaultLeaderboardURL = ""
+
+var (
+	leaderboardURL = flag.String("leaderboard", defaultLeaderboardURL, "URL of the online leaderboard server")
+	assetPack      = flag.String("pack", "", "directory or zip file of an asset pack")
+	noAudio        = flag.Bool("noaudio", false, "run without opening the audio device")
+	analogTrigger  = flag.Bool("analog-trigger", false, "scale the dive by the depth of the analog triggers of gamepads")
+)
+
 func main() {
-	game, err := game.NewGame()
+	flag.Parse()
+
+	if *noAudio {
+		game.DisableAudio()
+	}
+
+	storage, err := game.NewDefaultStorage()
+	if err != nil {
+		log.Println(err)
+	}
+	game, err := game.NewGame(storage)
 	if err != nil {
 		log.Fatal(err)
 	}
+	// Browsers have no flags, so the page may give the URL instead.
+	if u := pageLeaderboardURL(); u != "" {
+		*leaderboardURL = u
+	}
+	if *leaderboardURL != "" {
+		game.SetLeaderboardURL(*leaderboardURL)
+	}
+	// The flag overrides the setting only when it is given.
+	flag.Visit(func(f *flag.Flag) {
+		if f.Name == "analog-trigger" {
+			game.UseAnalogTriggerDive(*analogTrigger)
+		}
+	})
+	if *assetPack != "" {
+		if err := game.UseAssetPack(*assetPack); err != nil {
+			log.Fatal(err)
+		}
+	}
 	ebiten.SetWindowResizable(true)
 	ebiten.SetWindowTitle("Osushi")
 	if err := ebiten.RunGame(game); err != nil {
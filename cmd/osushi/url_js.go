@@ -0,0 +1,24 @@
+//go:build js
+// +build js
+
+package main
+
+import (
+	"net/url"
+	"strings"
+	"syscall/js"
+)
+
+// pageLeaderboardURL returns the leaderboard URL in the "leaderboard" query
+// parameter of the page, e.g. index.html?leaderboard=https://example.com.
+func pageLeaderboardURL() string {
+	search := js.Global().Get("location").Get("search")
+	if search.Type() != js.TypeString {
+		return ""
+	}
+	q, err := url.ParseQuery(strings.TrimPrefix(search.String(), "?"))
+	if err != nil {
+		return ""
+	}
+	return q.Get("leaderboard")
+}
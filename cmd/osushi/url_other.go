@@ -0,0 +1,9 @@
+//go:build !js
+// +build !js
+
+package main
+
+// pageLeaderboardURL returns an empty string since only browsers have a page.
+func pageLeaderboardURL() string {
+	return ""
+}
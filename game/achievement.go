@@ -0,0 +1,126 @@
+package game
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/rakyll/statik/fs"
+)
+
+const storageKeyAchievements = "achievements"
+
+// AchievementDef defines an achievement. It is unlocked when a stat of an
+// event reaches Min, or when the sum of the stat over all the events does if
+// Cumulative is set. The achievements of the game are defined in
+// assets/achievements.json.
+type AchievementDef struct {
+	ID         string    `json:"id"`
+	Title      string    `json:"title"`
+	Event      EventType `json:"event"`
+	Stat       string    `json:"stat"`
+	Min        float64   `json:"min"`
+	Cumulative bool      `json:"cumulative"`
+}
+
+// ParseAchievementDefs parses the definitions of achievements in JSON.
+func ParseAchievementDefs(data []byte) ([]AchievementDef, error) {
+	var defs []AchievementDef
+	if err := json.Unmarshal(data, &defs); err != nil {
+		return nil, err
+	}
+	return defs, nil
+}
+
+// achievementState is the persisted progress of the achievements.
+type achievementState struct {
+	Unlocked map[string]time.Time `json:"unlocked"`
+	Progress map[string]float64   `json:"progress"`
+}
+
+// Achievements evaluates the achievement definitions from the gameplay events.
+type Achievements struct {
+	defs  []AchievementDef
+	state achievementState
+
+	storage    Storage
+	onUnlocked func(def AchievementDef)
+}
+
+func NewAchievements(defs []AchievementDef, storage Storage, onUnlocked func(def AchievementDef)) *Achievements {
+	a := &Achievements{
+		defs: defs,
+		state: achievementState{
+			Unlocked: map[string]time.Time{},
+			Progress: map[string]float64{},
+		},
+		storage:    storage,
+		onUnlocked: onUnlocked,
+	}
+	loadJSON(storage, storageKeyAchievements, &a.state)
+	if a.state.Unlocked == nil {
+		a.state.Unlocked = map[string]time.Time{}
+	}
+	if a.state.Progress == nil {
+		a.state.Progress = map[string]float64{}
+	}
+	return a
+}
+
+func (a *Achievements) IsUnlocked(id string) bool {
+	_, ok := a.state.Unlocked[id]
+	return ok
+}
+
+func (a *Achievements) OnEvent(e Event) {
+	changed := false
+	for _, def := range a.defs {
+		if def.Event != e.Type || a.IsUnlocked(def.ID) {
+			continue
+		}
+		v, ok := e.Stats[def.Stat]
+		if !ok {
+			continue
+		}
+		if def.Cumulative {
+			if v == 0 {
+				continue
+			}
+			v += a.state.Progress[def.ID]
+			a.state.Progress[def.ID] = v
+			changed = true
+		}
+		if v < def.Min {
+			continue
+		}
+		a.state.Unlocked[def.ID] = time.Now()
+		delete(a.state.Progress, def.ID)
+		changed = true
+		if a.onUnlocked != nil {
+			a.onUnlocked(def)
+		}
+	}
+	if changed {
+		saveJSON(a.storage, storageKeyAchievements, &a.state)
+	}
+}
+
+// achievementDefs are the built-in achievements, which are none until loaded.
+var achievementDefs []AchievementDef
+
+// loadAchievementDefs reads the achievements in assets/achievements.json.
+func loadAchievementDefs() error {
+	statikFs, err := fs.New()
+	if err != nil {
+		return err
+	}
+	data, err := fs.ReadFile(statikFs, "/achievements.json")
+	if err != nil {
+		return err
+	}
+	defs, err := ParseAchievementDefs(data)
+	if err != nil {
+		return err
+	}
+	achievementDefs = defs
+	return nil
+}
@@ -0,0 +1,247 @@
+package game
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hajimehoshi/ebiten"
+	"github.com/hajimehoshi/ebiten/inpututil"
+)
+
+// Action is what the player does in the game, independent of the input
+// device.
+type Action int
+
+const (
+	ActionDive Action = iota
+	ActionJump
+	ActionPause
+	ActionMute
+	ActionRestart
+	ActionRotate
+	actionNum
+)
+
+var actionNames = [...]string{
+	ActionDive:    "dive",
+	ActionJump:    "jump",
+	ActionPause:   "pause",
+	ActionMute:    "mute",
+	ActionRestart: "restart",
+	ActionRotate:  "rotate",
+}
+
+func (a Action) String() string {
+	return actionNames[a]
+}
+
+func (a Action) MarshalText() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+func (a *Action) UnmarshalText(text []byte) error {
+	for i, name := range actionNames {
+		if name == string(text) {
+			*a = Action(i)
+			return nil
+		}
+	}
+	return fmt.Errorf("game: unknown action %q", text)
+}
+
+type Device int
+
+const (
+	DeviceKeyboard Device = iota
+	DeviceMouse
+	DeviceGamepad
+)
+
+// Binding is a key, a mouse button or a gamepad button. Gamepad buttons are
+// shared by all the connected gamepads.
+type Binding struct {
+	Device Device
+	Code   int
+}
+
+func keyBinding(k ebiten.Key) Binding {
+	return Binding{Device: DeviceKeyboard, Code: int(k)}
+}
+
+func mouseBinding(b ebiten.MouseButton) Binding {
+	return Binding{Device: DeviceMouse, Code: int(b)}
+}
+
+func gamepadBinding(b ebiten.GamepadButton) Binding {
+	return Binding{Device: DeviceGamepad, Code: int(b)}
+}
+
+var mouseButtonNames = map[ebiten.MouseButton]string{
+	ebiten.MouseButtonLeft:   "Left",
+	ebiten.MouseButtonRight:  "Right",
+	ebiten.MouseButtonMiddle: "Middle",
+}
+
+func (b Binding) String() string {
+	switch b.Device {
+	case DeviceKeyboard:
+		return "key:" + ebiten.Key(b.Code).String()
+	case DeviceMouse:
+		return "mouse:" + mouseButtonNames[ebiten.MouseButton(b.Code)]
+	case DeviceGamepad:
+		return "gamepad:" + strconv.Itoa(b.Code)
+	}
+	return ""
+}
+
+func (b Binding) MarshalText() ([]byte, error) {
+	return []byte(b.String()), nil
+}
+
+func (b *Binding) UnmarshalText(text []byte) error {
+	tokens := strings.SplitN(string(text), ":", 2)
+	if len(tokens) != 2 {
+		return fmt.Errorf("game: invalid binding %q", text)
+	}
+	switch tokens[0] {
+	case "key":
+		for k := ebiten.Key(0); k <= ebiten.KeyMax; k++ {
+			if k.String() == tokens[1] {
+				*b = keyBinding(k)
+				return nil
+			}
+		}
+	case "mouse":
+		for mb, name := range mouseButtonNames {
+			if name == tokens[1] {
+				*b = mouseBinding(mb)
+				return nil
+			}
+		}
+	case "gamepad":
+		n, err := strconv.Atoi(tokens[1])
+		if err == nil && n >= 0 && n <= int(ebiten.GamepadButtonMax) {
+			*b = gamepadBinding(ebiten.GamepadButton(n))
+			return nil
+		}
+	}
+	return fmt.Errorf("game: invalid binding %q", text)
+}
+
+// Bindings maps each action to the inputs which trigger it. Touches always
+// trigger ActionDive and ActionJump since they can't be told apart.
+type Bindings map[Action][]Binding
+
+func DefaultBindings() Bindings {
+	// Dive and jump share the same inputs: holding dives and releasing jumps.
+	diveJump := []Binding{
+		keyBinding(ebiten.KeySpace),
+		mouseBinding(ebiten.MouseButtonLeft),
+	}
+	for _, b := range gamepadDiveButtons {
+		diveJump = append(diveJump, gamepadBinding(b))
+	}
+	return Bindings{
+		ActionDive:    diveJump,
+		ActionJump:    append([]Binding(nil), diveJump...),
+		ActionPause:   {keyBinding(ebiten.KeyEscape), keyBinding(ebiten.KeyP), gamepadBinding(gamepadButtonStart)},
+		ActionMute:    {keyBinding(ebiten.KeyM)},
+		ActionRestart: {keyBinding(ebiten.KeyR), gamepadBinding(gamepadButtonBack)},
+		ActionRotate:  {keyBinding(ebiten.KeyX), mouseBinding(ebiten.MouseButtonRight), gamepadBinding(gamepadButtonB)},
+	}
+}
+
+// actionInput turns the bound inputs into the state of each action.
+type actionInput struct {
+	bindings Bindings
+
+	depth     [actionNum]float64
+	prevDepth [actionNum]float64
+}
+
+var actions = &actionInput{
+	bindings: DefaultBindings(),
+}
+
+// Update must be called after input.Update and after the UI has claimed its
+// pointers and gamepad buttons.
+func (a *actionInput) Update() {
+	for i := Action(0); i < actionNum; i++ {
+		a.prevDepth[i] = a.depth[i]
+		a.depth[i] = a.readDepth(i)
+	}
+}
+
+func (a *actionInput) readDepth(action Action) float64 {
+	depth := 0.0
+	if action == ActionDive || action == ActionJump {
+		if input.IsTouchPressed() {
+			return 1
+		}
+		depth = gamepad.TriggerDepth()
+	}
+	for _, b := range a.bindings[action] {
+		if isBindingPressed(b) {
+			return 1
+		}
+	}
+	return depth
+}
+
+func isBindingPressed(b Binding) bool {
+	switch b.Device {
+	case DeviceKeyboard:
+		return ebiten.IsKeyPressed(ebiten.Key(b.Code))
+	case DeviceMouse:
+		if ebiten.MouseButton(b.Code) == ebiten.MouseButtonLeft {
+			// The left button may be claimed by UI.
+			return input.IsMousePressed()
+		}
+		return ebiten.IsMouseButtonPressed(ebiten.MouseButton(b.Code))
+	case DeviceGamepad:
+		return gamepad.IsButtonPressed(ebiten.GamepadButton(b.Code))
+	}
+	return false
+}
+
+// Depth returns how deep the action is pressed in [0, 1]. Only analog
+// triggers give values between 0 and 1.
+func (a *actionInput) Depth(action Action) float64 {
+	return a.depth[action]
+}
+
+func (a *actionInput) IsPressed(action Action) bool {
+	return a.depth[action] > 0
+}
+
+func (a *actionInput) IsJustPressed(action Action) bool {
+	return a.prevDepth[action] == 0 && a.depth[action] > 0
+}
+
+func (a *actionInput) IsJustReleased(action Action) bool {
+	return a.prevDepth[action] > 0 && a.depth[action] == 0
+}
+
+// justPressedBinding returns an input pressed in the current tick, which is
+// used to rebind an action.
+func justPressedBinding() (Binding, bool) {
+	for k := ebiten.Key(0); k <= ebiten.KeyMax; k++ {
+		if inpututil.IsKeyJustPressed(k) {
+			return keyBinding(k), true
+		}
+	}
+	for b := range mouseButtonNames {
+		if inpututil.IsMouseButtonJustPressed(b) {
+			return mouseBinding(b), true
+		}
+	}
+	for _, id := range ebiten.GamepadIDs() {
+		for b := ebiten.GamepadButton(0); b <= ebiten.GamepadButtonMax; b++ {
+			if inpututil.IsGamepadButtonJustPressed(id, b) {
+				return gamepadBinding(b), true
+			}
+		}
+	}
+	return Binding{}, false
+}
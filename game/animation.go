@@ -0,0 +1,159 @@
+package game
+
+import (
+	"image"
+
+	"github.com/hajimehoshi/ebiten"
+)
+
+type AnimationMode int
+
+const (
+	// AnimationLoop repeats the clip until another one is played.
+	AnimationLoop AnimationMode = iota
+	// AnimationOnce stops at the last frame of the clip.
+	AnimationOnce
+)
+
+// AnimationEventEnd is the event of a clip in AnimationOnce reaching its end.
+const AnimationEventEnd = "end"
+
+// AnimationFrame is a frame of a clip. Sprite is the name of the sprite,
+// which asset packs can override, and Rect is the area of the frame in it
+// when the sprite is a sheet of frames. Event is emitted when the frame
+// starts if it is not empty.
+type AnimationFrame struct {
+	Sprite string
+	Rect   image.Rectangle
+	Ticks  int
+	Event  string
+}
+
+// SheetFrames returns the frames of a sprite sheet which has frames of w x h
+// in a row, each lasting for ticks.
+func SheetFrames(sprite string, w, h, n, ticks int) []AnimationFrame {
+	frames := make([]AnimationFrame, n)
+	for i := range frames {
+		frames[i] = AnimationFrame{
+			Sprite: sprite,
+			Rect:   image.Rect(w*i, 0, w*(i+1), h),
+			Ticks:  ticks,
+		}
+	}
+	return frames
+}
+
+func (f *AnimationFrame) image() *ebiten.Image {
+	img := spriteImage(f.Sprite)
+	if f.Rect.Empty() {
+		return img
+	}
+	return img.SubImage(f.Rect).(*ebiten.Image)
+}
+
+type AnimationClip struct {
+	Name   string
+	Frames []AnimationFrame
+	Mode   AnimationMode
+}
+
+// Animator plays one of its clips at a time.
+type Animator struct {
+	clips   map[string]*AnimationClip
+	current *AnimationClip
+	frame   int
+	ticks   int
+	done    bool
+
+	// OnEvent is called with the name of the clip for the events of the
+	// frames and AnimationEventEnd.
+	OnEvent func(clip, event string)
+}
+
+func NewAnimator(clips ...*AnimationClip) *Animator {
+	a := &Animator{
+		clips: map[string]*AnimationClip{},
+	}
+	for _, c := range clips {
+		a.clips[c.Name] = c
+	}
+	return a
+}
+
+// Play starts the clip of name from its first frame. It does nothing if the
+// clip is already playing.
+func (a *Animator) Play(name string) {
+	if a.current != nil && a.current.Name == name {
+		return
+	}
+	a.Restart(name)
+}
+
+// Restart starts the clip of name from its first frame even if it is already
+// playing. It does nothing if there is no clip of name.
+func (a *Animator) Restart(name string) {
+	c, ok := a.clips[name]
+	if !ok {
+		return
+	}
+	a.current = c
+	a.frame = 0
+	a.ticks = 0
+	a.done = false
+	a.emit(a.current.Frames[0].Event)
+}
+
+// Current returns the name of the playing clip.
+func (a *Animator) Current() string {
+	if a.current == nil {
+		return ""
+	}
+	return a.current.Name
+}
+
+// Done reports whether the playing clip in AnimationOnce has reached its end.
+func (a *Animator) Done() bool {
+	return a.done
+}
+
+// Busy reports whether a clip in AnimationOnce is playing and hasn't reached
+// its end.
+func (a *Animator) Busy() bool {
+	return a.current != nil && a.current.Mode == AnimationOnce && !a.done
+}
+
+func (a *Animator) Update() {
+	if a.current == nil || a.done {
+		return
+	}
+	a.ticks++
+	if a.ticks < a.current.Frames[a.frame].Ticks {
+		return
+	}
+	a.ticks = 0
+	if a.frame == len(a.current.Frames)-1 {
+		if a.current.Mode == AnimationOnce {
+			a.done = true
+			a.emit(AnimationEventEnd)
+			return
+		}
+		a.frame = 0
+	} else {
+		a.frame++
+	}
+	a.emit(a.current.Frames[a.frame].Event)
+}
+
+func (a *Animator) emit(event string) {
+	if event != "" && a.OnEvent != nil {
+		a.OnEvent(a.current.Name, event)
+	}
+}
+
+// Image returns the image of the current frame.
+func (a *Animator) Image() *ebiten.Image {
+	if a.current == nil {
+		return nil
+	}
+	return a.current.Frames[a.frame].image()
+}
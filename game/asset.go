@@ -1,20 +1,23 @@
 package game
 
 import (
+	"fmt"
 	"image"
+	"image/color"
 	_ "image/png"
-	"log"
-	"net/http"
+	"sort"
+	"strings"
 
-	"github.com/golang/freetype/truetype"
 	"github.com/hajimehoshi/ebiten"
 	"github.com/hajimehoshi/ebiten/examples/resources/fonts"
 	_ "github.com/hiroebe/osushi/game/statik"
 	"github.com/rakyll/statik/fs"
 	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
 )
 
-//go:generate statik -m -src images
+//go:generate go run ../cmd/osushi-atlas -src images -out assets -reserve mountain=512x512,underground=128x128,surface=1x1
+//go:generate statik -m -src assets
 
 var (
 	gopherImageNormal     *ebiten.Image
@@ -24,44 +27,120 @@ var (
 	soundIconOn           *ebiten.Image
 	soundIconOff          *ebiten.Image
 
-	arcadeFont font.Face
+	// sprites are the images which asset packs can override, by their names
+	// in the manifests.
+	sprites = map[string]**ebiten.Image{
+		"gopher-normal":     &gopherImageNormal,
+		"gopher-accelerate": &gopherImageAcceralate,
+		"gopher-fly-1":      &gopherImageFly1,
+		"gopher-fly-2":      &gopherImageFly2,
+		"volume-on":         &soundIconOn,
+		"volume-off":        &soundIconOff,
+	}
+	// paletteColors are the colors which asset packs can override.
+	paletteColors = map[string]*color.NRGBA{
+		"background":        &backgroundColor,
+		"ground-surface":    &groundSurfaceColor,
+		"underground-1":     &undergroundColor1,
+		"underground-2":     &undergroundColor2,
+		"widget":            &widgetColor,
+		"widget-active":     &widgetActiveColor,
+		"widget-background": &widgetBackgroundColor,
+	}
+	builtinSprites = map[string]*ebiten.Image{}
+	builtinColors  = map[string]color.NRGBA{}
+	// builtinAtlas has the built-in sprites and the pattern tiles. It is
+	// empty until loaded, when the tiles are separate images.
+	builtinAtlas = &Atlas{}
+	// placeholderImage is shown for the sprites which failed to load.
+	placeholderImage *ebiten.Image
+
+	fontManager *FontManager
+	// uiFont is the face of the UI text. The arcade font has only ASCII
+	// characters, so the others fall back to M+.
+	uiFont font.Face = basicfont.Face7x13
 )
 
 func init() {
-	statikFs, err := fs.New()
-	if err != nil {
-		log.Fatal(err)
+	placeholderImage = newPlaceholderImage()
+	for name, img := range sprites {
+		*img = placeholderImage
+		builtinSprites[name] = placeholderImage
 	}
-	gopherImageNormal = mustLoadImage(statikFs, "/gopher-normal.png")
-	gopherImageAcceralate = mustLoadImage(statikFs, "/gopher-acceralate.png")
-	gopherImageFly1 = mustLoadImage(statikFs, "/gopher-fly-1.png")
-	gopherImageFly2 = mustLoadImage(statikFs, "/gopher-fly-2.png")
-	soundIconOn = mustLoadImage(statikFs, "/volume-on.png")
-	soundIconOff = mustLoadImage(statikFs, "/volume-off.png")
+	for name, c := range paletteColors {
+		builtinColors[name] = *c
+	}
+}
 
-	tt, err := truetype.Parse(fonts.ArcadeN_ttf)
-	if err != nil {
-		log.Fatal(err)
+// newPlaceholderImage returns a checkered image which stands out where a
+// sprite failed to load.
+func newPlaceholderImage() *ebiten.Image {
+	const size, cell = 16, 4
+	magenta := color.NRGBA{0xff, 0x00, 0xff, 0xff}
+	src := image.NewNRGBA(image.Rect(0, 0, size, size))
+	for y := 0; y < size; y++ {
+		for x := 0; x < size; x++ {
+			if (x/cell+y/cell)%2 == 0 {
+				src.SetNRGBA(x, y, magenta)
+			} else {
+				src.Set(x, y, color.Black)
+			}
+		}
 	}
-	arcadeFont = truetype.NewFace(tt, &truetype.Options{
-		Size:    fontSize,
-		DPI:     72,
-		Hinting: font.HintingFull,
-	})
+	img, _ := ebiten.NewImageFromImage(src, ebiten.FilterDefault)
+	return img
 }
 
-func mustLoadImage(fs http.FileSystem, name string) *ebiten.Image {
-	f, err := fs.Open(name)
+// loadBuiltinAtlas loads the atlas and the built-in sprites in it. The sprites
+// missing in the atlas stay the placeholder.
+func loadBuiltinAtlas() error {
+	statikFs, err := fs.New()
 	if err != nil {
-		log.Fatal(err)
+		return err
 	}
-	img, _, err := image.Decode(f)
+	a, err := loadAtlas(statikFs)
 	if err != nil {
-		log.Fatal(err)
+		return err
 	}
-	ebitenImg, err := ebiten.NewImageFromImage(img, ebiten.FilterDefault)
+	builtinAtlas = a
+	var missing []string
+	for name, img := range sprites {
+		sub, ok := a.Image(name)
+		if !ok {
+			missing = append(missing, name)
+			continue
+		}
+		*img = sub
+		builtinSprites[name] = sub
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return fmt.Errorf("sprites %s are not in the atlas", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// loadFonts parses the UI fonts. Until then, or if they fail, the UI text is
+// drawn in a basic bitmap font.
+func loadFonts() error {
+	m, err := NewFontManager(fonts.ArcadeN_ttf, fonts.MPlus1pRegular_ttf)
 	if err != nil {
-		log.Fatal(err)
+		return err
 	}
-	return ebitenImg
+	fontManager = m
+	uiFont = uiFace(fontSize)
+	return nil
+}
+
+// uiFace returns the face of the UI text of size in logical pixels.
+func uiFace(size int) font.Face {
+	if fontManager == nil {
+		return basicfont.Face7x13
+	}
+	return fontManager.Face(dp(size))
+}
+
+// spriteImage returns the current image of the sprite of name.
+func spriteImage(name string) *ebiten.Image {
+	return *sprites[name]
 }
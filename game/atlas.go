@@ -0,0 +1,92 @@
+package game
+
+import (
+	"encoding/json"
+	"fmt"
+	"image"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/hajimehoshi/ebiten"
+	"github.com/hiroebe/osushi/atlas"
+)
+
+const (
+	atlasImageName = "/atlas.png"
+	atlasIndexName = "/atlas.json"
+)
+
+// Atlas is the texture atlas of the built-in images, made by osushi-atlas at
+// go generate. Sprites are sub-images of one texture, and the areas reserved
+// for the pattern tiles are drawn at run time.
+type Atlas struct {
+	img   *ebiten.Image
+	rects map[string]image.Rectangle
+}
+
+func loadAtlas(fs http.FileSystem) (*Atlas, error) {
+	f, err := fs.Open(atlasIndexName)
+	if err != nil {
+		return nil, err
+	}
+	data, err := ioutil.ReadAll(f)
+	f.Close()
+	if err != nil {
+		return nil, err
+	}
+	var idx atlas.Index
+	if err := json.Unmarshal(data, &idx); err != nil {
+		return nil, fmt.Errorf("game: failed to parse %q: %v", atlasIndexName, err)
+	}
+
+	f, err = fs.Open(atlasImageName)
+	if err != nil {
+		return nil, err
+	}
+	src, _, err := image.Decode(f)
+	f.Close()
+	if err != nil {
+		return nil, fmt.Errorf("game: failed to decode %q: %v", atlasImageName, err)
+	}
+	img, err := ebiten.NewImageFromImage(src, ebiten.FilterDefault)
+	if err != nil {
+		return nil, err
+	}
+	a := &Atlas{
+		img:   img,
+		rects: map[string]image.Rectangle{},
+	}
+	for name, r := range idx.Images {
+		a.rects[name] = r.Rectangle()
+	}
+	return a, nil
+}
+
+// Image returns the sub-image of name.
+func (a *Atlas) Image(name string) (*ebiten.Image, bool) {
+	r, ok := a.rects[name]
+	if !ok {
+		return nil, false
+	}
+	return a.img.SubImage(r).(*ebiten.Image), true
+}
+
+// Put replaces the area of name with src of the same size and returns the
+// sub-image. If the atlas has no such area, it returns a separate image of
+// src instead.
+func (a *Atlas) Put(name string, src image.Image) (*ebiten.Image, error) {
+	r, ok := a.rects[name]
+	if !ok || r.Size() != src.Bounds().Size() {
+		return ebiten.NewImageFromImage(src, ebiten.FilterDefault)
+	}
+	tmp, err := ebiten.NewImageFromImage(src, ebiten.FilterDefault)
+	if err != nil {
+		return nil, err
+	}
+	defer tmp.Dispose()
+	opts := &ebiten.DrawImageOptions{}
+	opts.CompositeMode = ebiten.CompositeModeCopy
+	opts.GeoM.Translate(float64(r.Min.X), float64(r.Min.Y))
+	a.img.DrawImage(tmp, opts)
+	return a.img.SubImage(r).(*ebiten.Image), nil
+}
@@ -0,0 +1,132 @@
+package game
+
+import (
+	"log"
+	"sync"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/audio"
+	"github.com/hajimehoshi/ebiten/audio/wav"
+)
+
+// soundPlayer plays a sound. *audio.Player implements it.
+type soundPlayer interface {
+	Play() error
+	Pause() error
+	Rewind() error
+	IsPlaying() bool
+	Current() time.Duration
+	SetVolume(volume float64)
+	Close() error
+}
+
+// audioBackend makes the players of the sounds.
+type audioBackend interface {
+	NewPlayer(src audio.ReadSeekCloser) (soundPlayer, error)
+	// DecodeWAV returns the samples of a WAV file and their length in bytes.
+	DecodeWAV(data []byte) (audio.ReadSeekCloser, int64, error)
+}
+
+// audioOut is the backend of the sounds. It is silent until the audio is
+// initialized, and stays so if it fails or is disabled.
+var audioOut audioBackend = nullAudio{}
+
+var (
+	audioDisabled   bool
+	audioDisabledMu sync.Mutex
+)
+
+// DisableAudio makes the game silent without opening any audio device, e.g.
+// for headless runs. It must be called before the game starts.
+func DisableAudio() {
+	audioDisabledMu.Lock()
+	defer audioDisabledMu.Unlock()
+	audioDisabled = true
+}
+
+func initAudio() error {
+	audioDisabledMu.Lock()
+	disabled := audioDisabled
+	audioDisabledMu.Unlock()
+	if disabled {
+		return nil
+	}
+	c, err := audio.NewContext(sampleRate)
+	if err != nil {
+		return err
+	}
+	audioOut = &ebitenAudio{context: c}
+	return nil
+}
+
+// newSoundPlayer returns a player of src, or a silent one if the backend
+// fails to make it.
+func newSoundPlayer(src audio.ReadSeekCloser) soundPlayer {
+	p, err := audioOut.NewPlayer(src)
+	if err != nil {
+		log.Println(err)
+		return &nullPlayer{}
+	}
+	return p
+}
+
+// ebitenAudio plays the sounds with the audio context of Ebiten.
+type ebitenAudio struct {
+	context *audio.Context
+}
+
+func (a *ebitenAudio) NewPlayer(src audio.ReadSeekCloser) (soundPlayer, error) {
+	return audio.NewPlayer(a.context, src)
+}
+
+func (a *ebitenAudio) DecodeWAV(data []byte) (audio.ReadSeekCloser, int64, error) {
+	s, err := wav.Decode(a.context, audio.BytesReadSeekCloser(data))
+	if err != nil {
+		return nil, 0, err
+	}
+	return s, s.Length(), nil
+}
+
+// nullAudio is a silent backend. Its players only keep their state.
+type nullAudio struct{}
+
+func (nullAudio) NewPlayer(src audio.ReadSeekCloser) (soundPlayer, error) {
+	return &nullPlayer{}, nil
+}
+
+func (nullAudio) DecodeWAV(data []byte) (audio.ReadSeekCloser, int64, error) {
+	return audio.BytesReadSeekCloser(data), int64(len(data)), nil
+}
+
+type nullPlayer struct {
+	playing bool
+}
+
+func (p *nullPlayer) Play() error {
+	p.playing = true
+	return nil
+}
+
+func (p *nullPlayer) Pause() error {
+	p.playing = false
+	return nil
+}
+
+func (p *nullPlayer) Rewind() error {
+	return nil
+}
+
+func (p *nullPlayer) IsPlaying() bool {
+	return p.playing
+}
+
+func (p *nullPlayer) Current() time.Duration {
+	return 0
+}
+
+func (p *nullPlayer) SetVolume(volume float64) {}
+
+func (p *nullPlayer) Close() error {
+	p.playing = false
+	return nil
+}
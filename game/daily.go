@@ -0,0 +1,67 @@
+package game
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/hiroebe/osushi/leaderboard"
+)
+
+const storageKeyDaily = "daily"
+
+// run is how the current run is played.
+type run struct {
+	mode string
+	// date is the date of the daily challenge in ModeDaily.
+	date string
+	// practice runs are not scored.
+	practice bool
+}
+
+func (r run) seed() int64 {
+	if r.mode == ModeDaily {
+		return leaderboard.DailySeed(r.date)
+	}
+	return rand.Int63()
+}
+
+// dailyState is the persisted state of the daily challenge.
+type dailyState struct {
+	// Date is the last date whose scored attempt was played.
+	Date string `json:"date"`
+}
+
+func (g *Game) dailyAttemptUsed(date string) bool {
+	s := &dailyState{}
+	loadJSON(g.storage, storageKeyDaily, s)
+	return s.Date == date
+}
+
+// startDaily starts today's daily challenge. The first run of a day is scored
+// and the later ones are practice.
+func (g *Game) startDaily() {
+	date := leaderboard.DailyDate(time.Now())
+	practice := g.dailyAttemptUsed(date)
+	if !practice {
+		saveJSON(g.storage, storageKeyDaily, &dailyState{Date: date})
+	}
+	g.startRun(run{mode: ModeDaily, date: date, practice: practice})
+}
+
+func (g *Game) newDailyMenu() *Menu {
+	date := leaderboard.DailyDate(time.Now())
+	status := tr("daily.triesLeft")
+	play := tr("daily.challenge")
+	if g.dailyAttemptUsed(date) {
+		status = tr("daily.played")
+		play = tr("daily.practice")
+	}
+	return NewMenu(tr("daily.title", date),
+		NewLabel(status),
+		NewButton(play, g.startDaily),
+		NewButton(tr("daily.normal"), func() {
+			g.startRun(run{mode: ModeNormal})
+		}),
+		NewButton(tr("menu.back"), g.closeMenu),
+	)
+}
@@ -0,0 +1,14 @@
+package game
+
+import "math"
+
+// deviceScale is the number of the device pixels in a logical pixel. The
+// screen is rendered at the native resolution in device pixels, while the
+// sizes of the UI and the HUD are given in logical pixels and converted with
+// dp.
+var deviceScale = 1.0
+
+// dp converts logical pixels to device pixels.
+func dp(v int) int {
+	return int(math.Round(float64(v) * deviceScale))
+}
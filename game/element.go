@@ -2,7 +2,6 @@ package game
 
 import (
 	"github.com/hajimehoshi/ebiten"
-	"github.com/hajimehoshi/ebiten/inpututil"
 )
 
 type Element interface {
@@ -20,6 +19,12 @@ type ElementImpl interface {
 	OnClick()
 }
 
+// Draggable is implemented by an ElementImpl which needs the pointer position
+// while it is pressed, such as a slider. x and y are relative to the element.
+type Draggable interface {
+	OnDrag(x, y, w, h int)
+}
+
 func NewElement(impl ElementImpl) Element {
 	return &ElementBase{
 		impl: impl,
@@ -29,38 +34,40 @@ func NewElement(impl ElementImpl) Element {
 type ElementBase struct {
 	impl ElementImpl
 
-	x, y     int
-	w, h     int
-	touchID  int
-	touching bool
+	x, y int
+	w, h int
 }
 
 func (e *ElementBase) Update() {
-	if ids := inpututil.JustPressedTouchIDs(); len(ids) == 1 {
-		id := ids[0]
-		x, y := ebiten.TouchPosition(id)
-		if e.isInside(x, y) {
-			e.touchID = id
-			e.touching = true
+	for _, p := range input.Pointers() {
+		if p.JustPressed && !p.IsClaimed() && e.isInside(p.X, p.Y) {
+			p.Claim(e)
 		}
-	}
-	if inpututil.IsMouseButtonJustReleased(ebiten.MouseButtonLeft) {
-		cursorX, cursorY := ebiten.CursorPosition()
-		if e.isInside(cursorX, cursorY) {
-			e.impl.OnClick()
+		if !p.IsClaimedBy(e) {
+			continue
 		}
+		if p.JustReleased {
+			if e.isInside(p.X, p.Y) {
+				e.impl.OnClick()
+			}
+			continue
+		}
+		e.drag(p.X, p.Y)
 	}
-	if !e.touching {
-		return
-	}
-	if inpututil.IsTouchJustReleased(e.touchID) {
-		e.impl.OnClick()
-		e.touching = false
+}
+
+func (e *ElementBase) Activate() {
+	e.impl.OnClick()
+}
+
+func (e *ElementBase) drag(cursorX, cursorY int) {
+	d, ok := e.impl.(Draggable)
+	if !ok {
 		return
 	}
-	if !e.isInside(ebiten.TouchPosition(e.touchID)) {
-		e.touching = false
-	}
+	x, y := e.Position()
+	w, h := e.Size()
+	d.OnDrag(cursorX-x, cursorY-y, w, h)
 }
 
 func (e *ElementBase) Draw(screen *ebiten.Image) {
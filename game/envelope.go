@@ -0,0 +1,48 @@
+package game
+
+import (
+	"time"
+
+	"github.com/hajimehoshi/ebiten"
+)
+
+// Envelope shapes the volume of a note. The volume rises to the peak in
+// Attack, falls to the Sustain level in Decay and stays there until the note
+// is released, and then fades out in Release. Fading in and out keeps the
+// notes from clicking at their boundaries.
+type Envelope struct {
+	Attack  time.Duration
+	Decay   time.Duration
+	Sustain float64
+	Release time.Duration
+}
+
+func durationToSamples(d time.Duration) int64 {
+	return int64(d) * sampleRate / int64(time.Second)
+}
+
+// level returns the volume at the sample pos of a note, which is released at
+// the sample releasePos if it is not negative.
+func (e *Envelope) level(pos, releasePos int64) float64 {
+	if releasePos >= 0 && pos >= releasePos {
+		r := durationToSamples(e.Release)
+		if pos-releasePos >= r {
+			return 0
+		}
+		return e.level(releasePos, -1) * (1 - float64(pos-releasePos)/float64(r))
+	}
+	a := durationToSamples(e.Attack)
+	if pos < a {
+		return float64(pos) / float64(a)
+	}
+	d := durationToSamples(e.Decay)
+	if pos < a+d {
+		return 1 - (1-e.Sustain)*float64(pos-a)/float64(d)
+	}
+	return e.Sustain
+}
+
+// ReleaseTicks returns the number of ticks a note takes to fade out.
+func (e *Envelope) ReleaseTicks() int {
+	return int((e.Release*time.Duration(ebiten.MaxTPS()) + time.Second - 1) / time.Second)
+}
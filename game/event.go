@@ -0,0 +1,107 @@
+package game
+
+type EventType string
+
+const (
+	EventJump EventType = "jump"
+	EventLand EventType = "land"
+	// EventRunEnd is emitted when a run is restarted.
+	EventRunEnd EventType = "run_end"
+)
+
+// Stats of the events.
+const (
+	StatHeight    = "height"
+	StatLength    = "length"
+	StatAirtime   = "airtime"
+	StatMountains = "mountains"
+	StatPerfect   = "perfect"
+	// StatCombo is the number of perfect landings in a row.
+	StatCombo    = "combo"
+	StatFlips    = "flips"
+	StatTrick    = "trick"
+	StatDistance = "distance"
+)
+
+// perfectAlignment is the alignment above which a landing is perfect.
+const perfectAlignment = 0.95
+
+// Event is something which happened in gameplay. Stats are the numbers
+// describing it, e.g. the height of the jump for EventLand.
+type Event struct {
+	Type  EventType
+	Stats map[string]float64
+}
+
+// EventListener is notified of every event on the game loop.
+type EventListener interface {
+	OnEvent(e Event)
+}
+
+// eventTracker watches the player and emits the events.
+type eventTracker struct {
+	listeners []EventListener
+
+	mountains int
+	combo     int
+	prevX     float64
+}
+
+func (t *eventTracker) AddListener(l EventListener) {
+	t.listeners = append(t.listeners, l)
+}
+
+func (t *eventTracker) emit(e Event) {
+	for _, l := range t.listeners {
+		l.OnEvent(e)
+	}
+}
+
+// Update must be called after the player is updated in each tick.
+func (t *eventTracker) Update(p *Player, ground *Ground) {
+	if p.IsJumping {
+		if p.Jumped {
+			t.mountains = 0
+			t.emit(Event{Type: EventJump, Stats: map[string]float64{}})
+		}
+		for _, m := range ground.Mountains() {
+			if t.prevX < m.TopX() && m.TopX() <= p.X {
+				t.mountains++
+			}
+		}
+	}
+	if p.Landed {
+		perfect := 0.0
+		if p.LastJump.Alignment >= perfectAlignment {
+			perfect = 1
+			t.combo++
+		} else {
+			t.combo = 0
+		}
+		t.emit(Event{
+			Type: EventLand,
+			Stats: map[string]float64{
+				StatHeight:    p.LastJump.Height,
+				StatLength:    p.LastJump.Length,
+				StatAirtime:   ticksToSeconds(p.LastJump.Ticks),
+				StatMountains: float64(t.mountains),
+				StatPerfect:   perfect,
+				StatCombo:     float64(t.combo),
+				StatFlips:     float64(p.LastJump.Flips),
+				StatTrick:     float64(p.LastJump.Trick),
+			},
+		})
+	}
+	t.prevX = p.X
+}
+
+func (t *eventTracker) EndRun(p *Player) {
+	t.emit(Event{
+		Type: EventRunEnd,
+		Stats: map[string]float64{
+			StatDistance: p.X,
+		},
+	})
+	t.combo = 0
+	t.prevX = 0
+}
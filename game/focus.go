@@ -0,0 +1,263 @@
+package game
+
+import (
+	"math"
+
+	"github.com/hajimehoshi/ebiten"
+	"github.com/hajimehoshi/ebiten/ebitenutil"
+	"github.com/hajimehoshi/ebiten/inpututil"
+)
+
+const (
+	focusRingWidth  = 2
+	focusRingMargin = 2
+
+	stickThreshold = 0.5
+)
+
+type navigation int
+
+const (
+	navNone navigation = iota
+	navNext
+	navPrev
+	navUp
+	navDown
+	navLeft
+	navRight
+	navActivate
+)
+
+// Activatable is implemented by an Element which can be activated without
+// a pointer, e.g. by the Enter key while it is focused.
+type Activatable interface {
+	Activate()
+}
+
+// Adjustable is implemented by an ElementImpl whose value can be changed with
+// the left and right keys, such as a slider.
+type Adjustable interface {
+	Adjust(delta float64)
+}
+
+// stickDirs keeps the last direction of the left stick of each gamepad so that
+// holding the stick moves the focus only once.
+var stickDirs = map[int]navigation{}
+
+// readNavigation returns the navigation input in the current tick, and the ID
+// of the gamepad it came from, or -1 for the keyboard.
+func readNavigation() (nav navigation, gamepadID int) {
+	switch {
+	case inpututil.IsKeyJustPressed(ebiten.KeyTab):
+		if ebiten.IsKeyPressed(ebiten.KeyShift) {
+			return navPrev, -1
+		}
+		return navNext, -1
+	case inpututil.IsKeyJustPressed(ebiten.KeyUp):
+		return navUp, -1
+	case inpututil.IsKeyJustPressed(ebiten.KeyDown):
+		return navDown, -1
+	case inpututil.IsKeyJustPressed(ebiten.KeyLeft):
+		return navLeft, -1
+	case inpututil.IsKeyJustPressed(ebiten.KeyRight):
+		return navRight, -1
+	case inpututil.IsKeyJustPressed(ebiten.KeyEnter):
+		return navActivate, -1
+	}
+
+	for _, id := range ebiten.GamepadIDs() {
+		switch {
+		case inpututil.IsGamepadButtonJustPressed(id, gamepadButtonUp):
+			return navUp, id
+		case inpututil.IsGamepadButtonJustPressed(id, gamepadButtonDown):
+			return navDown, id
+		case inpututil.IsGamepadButtonJustPressed(id, gamepadButtonLeft):
+			return navLeft, id
+		case inpututil.IsGamepadButtonJustPressed(id, gamepadButtonRight):
+			return navRight, id
+		case inpututil.IsGamepadButtonJustPressed(id, gamepadButtonA):
+			return navActivate, id
+		}
+		if nav := readStick(id); nav != navNone {
+			return nav, id
+		}
+	}
+	return navNone, -1
+}
+
+func readStick(id int) navigation {
+	if ebiten.GamepadAxisNum(id) < 2 {
+		return navNone
+	}
+	x := ebiten.GamepadAxis(id, 0)
+	y := ebiten.GamepadAxis(id, 1)
+	dir := navNone
+	switch {
+	case y < -stickThreshold:
+		dir = navUp
+	case y > stickThreshold:
+		dir = navDown
+	case x < -stickThreshold:
+		dir = navLeft
+	case x > stickThreshold:
+		dir = navRight
+	}
+	if dir == stickDirs[id] {
+		return navNone
+	}
+	stickDirs[id] = dir
+	return dir
+}
+
+// FocusGroup moves the keyboard and gamepad focus among its elements. The
+// order of the elements is the tab order, and the arrow keys move the focus
+// to the nearest element in their direction.
+type FocusGroup struct {
+	elems   []Element
+	current int
+}
+
+func NewFocusGroup(elems ...Element) *FocusGroup {
+	return &FocusGroup{
+		elems:   elems,
+		current: -1,
+	}
+}
+
+func (f *FocusGroup) Add(elems ...Element) {
+	f.elems = append(f.elems, elems...)
+}
+
+// Focused returns the focused element, or nil if the focus is not shown.
+func (f *FocusGroup) Focused() Element {
+	if f.current < 0 {
+		return nil
+	}
+	return f.elems[f.current]
+}
+
+func (f *FocusGroup) Blur() {
+	f.current = -1
+}
+
+func (f *FocusGroup) Update() {
+	if len(f.elems) == 0 {
+		return
+	}
+	// Hide the focus ring while the player uses a pointer.
+	for _, p := range input.Pointers() {
+		if p.JustPressed {
+			f.current = -1
+		}
+	}
+
+	nav, gamepadID := readNavigation()
+	if nav == navNone {
+		return
+	}
+	if f.current < 0 {
+		if nav == navActivate {
+			// Nothing is focused. Leave the button to gameplay.
+			return
+		}
+		// The first key press only shows where the focus is.
+		f.current = 0
+		return
+	}
+
+	switch nav {
+	case navNext:
+		f.current = (f.current + 1) % len(f.elems)
+	case navPrev:
+		f.current = (f.current + len(f.elems) - 1) % len(f.elems)
+	case navLeft, navRight:
+		if a, ok := f.adjustable(); ok {
+			if nav == navLeft {
+				a.Adjust(-0.1)
+			} else {
+				a.Adjust(0.1)
+			}
+			return
+		}
+		f.moveTo(nav)
+	case navUp, navDown:
+		f.moveTo(nav)
+	case navActivate:
+		if a, ok := f.elems[f.current].(Activatable); ok {
+			a.Activate()
+		}
+		if gamepadID >= 0 {
+			gamepad.Consume(gamepadID, gamepadButtonA)
+		}
+	}
+}
+
+func (f *FocusGroup) adjustable() (Adjustable, bool) {
+	e, ok := f.elems[f.current].(*ElementBase)
+	if !ok {
+		return nil, false
+	}
+	a, ok := e.impl.(Adjustable)
+	return a, ok
+}
+
+func (f *FocusGroup) moveTo(nav navigation) {
+	cx, cy := elementCenter(f.elems[f.current])
+	best := -1
+	bestDist := math.Inf(1)
+	for i, e := range f.elems {
+		if i == f.current {
+			continue
+		}
+		x, y := elementCenter(e)
+		dx, dy := x-cx, y-cy
+		var along, across float64
+		switch nav {
+		case navUp:
+			along, across = -dy, dx
+		case navDown:
+			along, across = dy, dx
+		case navLeft:
+			along, across = -dx, dy
+		case navRight:
+			along, across = dx, dy
+		}
+		if along <= 0 {
+			continue
+		}
+		// Prefer the elements in line with the current one.
+		if d := along + 2*math.Abs(across); d < bestDist {
+			best = i
+			bestDist = d
+		}
+	}
+	if best >= 0 {
+		f.current = best
+	}
+}
+
+func elementCenter(e Element) (x, y float64) {
+	ex, ey := e.Position()
+	w, h := e.Size()
+	return float64(ex) + float64(w)/2, float64(ey) + float64(h)/2
+}
+
+// Draw draws the focus ring around the focused element.
+func (f *FocusGroup) Draw(screen *ebiten.Image) {
+	e := f.Focused()
+	if e == nil {
+		return
+	}
+	ex, ey := e.Position()
+	ew, eh := e.Size()
+	rw, margin := dp(focusRingWidth), dp(focusRingMargin)
+	x := float64(ex - margin - rw)
+	y := float64(ey - margin - rw)
+	w := float64(ew + (margin+rw)*2)
+	h := float64(eh + (margin+rw)*2)
+	fw := float64(rw)
+	ebitenutil.DrawRect(screen, x, y, w, fw, widgetActiveColor)
+	ebitenutil.DrawRect(screen, x, y+h-fw, w, fw, widgetActiveColor)
+	ebitenutil.DrawRect(screen, x, y, fw, h, widgetActiveColor)
+	ebitenutil.DrawRect(screen, x+w-fw, y, fw, h, widgetActiveColor)
+}
@@ -0,0 +1,135 @@
+package game
+
+import (
+	"image"
+	"sync"
+
+	"github.com/golang/freetype/truetype"
+	"golang.org/x/image/font"
+	"golang.org/x/image/math/fixed"
+)
+
+// FontManager makes faces of any size from a chain of fonts. Each character
+// is drawn with the first font in the chain which has it, e.g. the arcade
+// font for ASCII and a CJK font for Japanese.
+type FontManager struct {
+	fonts []*truetype.Font
+
+	m     sync.Mutex
+	faces map[int]*fallbackFace
+}
+
+// NewFontManager parses the TrueType fonts in the order of the fallback.
+func NewFontManager(ttfs ...[]byte) (*FontManager, error) {
+	m := &FontManager{
+		faces: map[int]*fallbackFace{},
+	}
+	for _, ttf := range ttfs {
+		f, err := truetype.Parse(ttf)
+		if err != nil {
+			return nil, err
+		}
+		m.fonts = append(m.fonts, f)
+	}
+	return m, nil
+}
+
+// Face returns the face of size in pixels. Faces are cached, so that Ebiten
+// can reuse the glyph images drawn for them.
+func (m *FontManager) Face(size int) font.Face {
+	m.m.Lock()
+	defer m.m.Unlock()
+	if f, ok := m.faces[size]; ok {
+		return f
+	}
+	f := &fallbackFace{
+		fonts:  m.fonts,
+		glyphs: map[rune]int{},
+	}
+	for _, tt := range m.fonts {
+		f.faces = append(f.faces, truetype.NewFace(tt, &truetype.Options{
+			Size:    float64(size),
+			DPI:     72,
+			Hinting: font.HintingFull,
+		}))
+	}
+	m.faces[size] = f
+	return f
+}
+
+// fallbackFace is a font.Face which draws each character with the first face
+// having it. Its metrics cover all the faces so that any string fits in the
+// height.
+type fallbackFace struct {
+	fonts []*truetype.Font
+	faces []font.Face
+
+	m sync.Mutex
+	// glyphs caches the index of the face for each character.
+	glyphs map[rune]int
+}
+
+func (f *fallbackFace) face(r rune) font.Face {
+	f.m.Lock()
+	defer f.m.Unlock()
+	i, ok := f.glyphs[r]
+	if !ok {
+		// The first face draws the missing characters as its notdef glyph.
+		for j, tt := range f.fonts {
+			if tt.Index(r) != 0 {
+				i = j
+				break
+			}
+		}
+		f.glyphs[r] = i
+	}
+	return f.faces[i]
+}
+
+func (f *fallbackFace) Close() error {
+	for _, face := range f.faces {
+		if err := face.Close(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f *fallbackFace) Glyph(dot fixed.Point26_6, r rune) (dr image.Rectangle, mask image.Image, maskp image.Point, advance fixed.Int26_6, ok bool) {
+	return f.face(r).Glyph(dot, r)
+}
+
+func (f *fallbackFace) GlyphBounds(r rune) (bounds fixed.Rectangle26_6, advance fixed.Int26_6, ok bool) {
+	return f.face(r).GlyphBounds(r)
+}
+
+func (f *fallbackFace) GlyphAdvance(r rune) (advance fixed.Int26_6, ok bool) {
+	return f.face(r).GlyphAdvance(r)
+}
+
+// Kern returns the kerning between the characters only if they are in the
+// same face.
+func (f *fallbackFace) Kern(r0, r1 rune) fixed.Int26_6 {
+	face := f.face(r0)
+	if face != f.face(r1) {
+		return 0
+	}
+	return face.Kern(r0, r1)
+}
+
+func (f *fallbackFace) Metrics() font.Metrics {
+	var m font.Metrics
+	for _, face := range f.faces {
+		fm := face.Metrics()
+		if fm.Height > m.Height {
+			m.Height = fm.Height
+		}
+		if fm.Ascent > m.Ascent {
+			m.Ascent = fm.Ascent
+		}
+		if fm.Descent > m.Descent {
+			m.Descent = fm.Descent
+		}
+	}
+	return m
+}
@@ -3,20 +3,26 @@ package game
 import (
 	"fmt"
 	"image/color"
+	"log"
+	"math"
 	"math/rand"
 	"time"
 
 	"github.com/hajimehoshi/ebiten"
 	"github.com/hajimehoshi/ebiten/ebitenutil"
-	"github.com/hajimehoshi/ebiten/text"
+	"github.com/hiroebe/osushi/leaderboard"
+	"github.com/hiroebe/osushi/physics"
 )
 
+// fontSize and iconSize are in logical pixels, and playerOffset is in the
+// world units shown at a logical pixel each without zoom.
 const (
 	fontSize     = 16
 	iconSize     = 32
 	playerOffset = 64
 )
 
+// screenWidth and screenHeight are in device pixels.
 var (
 	screenWidth  int
 	screenHeight int
@@ -31,8 +37,9 @@ type volumeSetter interface {
 }
 
 type soundIcon struct {
-	setters []volumeSetter
-	isMuted bool
+	setters  []volumeSetter
+	isMuted  bool
+	onToggle func()
 }
 
 func (i *soundIcon) Draw(screen *ebiten.Image, x, y, w, h int) {
@@ -49,6 +56,9 @@ func (i *soundIcon) Size() (w, h int) {
 
 func (i *soundIcon) OnClick() {
 	i.setMuted(!i.isMuted)
+	if i.onToggle != nil {
+		i.onToggle()
+	}
 }
 
 func (i *soundIcon) setMuted(muted bool) {
@@ -72,54 +82,289 @@ func (i *soundIcon) img() *ebiten.Image {
 type Game struct {
 	player           *Player
 	ground           *Ground
-	soundIcon        Element
+	ui               *AnchorLayout
+	focus            *FocusGroup
+	menus            []*Menu
+	rebind           *rebindState
+	soundIcon        *soundIcon
+	soundIconElem    Element
 	scale            float64
 	jumpHeightRecord int
 	jumpLendthRecord int
+	// jumpAirtimeRecord is in ticks.
+	jumpAirtimeRecord int
+	trickRecord       int
+
+	storage       Storage
+	loaded        bool
+	recordUpdated bool
+	// language is the language chosen in the settings, or empty to follow
+	// the platform.
+	language Language
+	// pack is the name of the asset pack chosen in the settings, and
+	// packFixed is set when another pack is given by UseAssetPack.
+	pack         string
+	packFixed    bool
+	leaderboard  *Leaderboard
+	online       *leaderboard.Client
+	replay       *physics.Replay
+	run          run
+	events       *eventTracker
+	achievements *Achievements
+	stats        *LifetimeStats
+	toast        *Toast
+	// pending are the scores of the run, which are added to the leaderboard
+	// when it ends.
+	pending []pendingEntry
+	// analogTriggerFixed is set when the setting is given by
+	// UseAnalogTriggerDive.
+	analogTriggerFixed bool
+
+	// tasks are run on the game loop, e.g. for results of requests made in
+	// other goroutines.
+	tasks chan func()
 
+	sounds         *SoundScheduler
 	newRecordSound *NewRecordSound
+	// effects are the sound effects played once, by the names of the sounds.
+	effects map[string]*EffectSound
 }
 
-func NewGame() (*Game, error) {
-	jumpSound := NewJumpSound()
-	newRecordSound := NewNewRecordSound()
-
-	soundIcon := &soundIcon{
-		setters: []volumeSetter{jumpSound, newRecordSound},
+// NewGame creates a game which keeps records and settings in storage. If
+// storage is nil, they are kept only in memory.
+func NewGame(storage Storage) (*Game, error) {
+	if storage == nil {
+		storage = NewMemoryStorage()
 	}
+
+	// The sounds are created after the audio is loaded.
+	soundIcon := &soundIcon{}
 	soundIcon.setMuted(true)
 	soundIconElem := NewElement(soundIcon)
-	soundIconElem.SetSize(iconSize, iconSize)
+	soundIconElem.SetSize(dp(iconSize), dp(iconSize))
+
+	toast := NewToast()
+	ui := NewAnchorLayout()
+	ui.Add(soundIconElem, AnchorTopRight, 0, 0)
+	ui.Add(toast, AnchorTop, 0, widgetPadding)
+
+	r := run{mode: ModeNormal}
+	ground := NewGround(r.seed())
+	g := &Game{
+		ground:        ground,
+		replay:        physics.NewReplay(ground.Seed()),
+		run:           r,
+		ui:            ui,
+		focus:         NewFocusGroup(soundIconElem),
+		soundIcon:     soundIcon,
+		soundIconElem: soundIconElem,
+		scale:         1,
+		storage:       storage,
+		sounds:        NewSoundScheduler(),
+		tasks:         make(chan func(), 16),
+		events:        &eventTracker{},
+		toast:         toast,
+	}
+	g.player = NewPlayer(nil, g.playEffect)
+	soundIcon.onToggle = g.saveSettings
+	return g, nil
+}
+
+// records is the persisted state of the records.
+type records struct {
+	JumpHeight int `json:"jumpHeight"`
+	JumpLength int `json:"jumpLength"`
+	// JumpAirtime is in ticks.
+	JumpAirtime int `json:"jumpAirtime"`
+	// Tricks is the trick points of a run.
+	Tricks int `json:"tricks"`
+}
+
+// load creates the sounds and reads the persisted state once the assets are
+// loaded. It is called on the game loop rather than in NewGame since mobile
+// hosts register their store after the game is created.
+func (g *Game) load() {
+	g.reportLoadErrors()
+	g.reloadSounds()
+
+	r := &records{}
+	loadJSON(g.storage, storageKeyRecords, r)
+	g.jumpHeightRecord = r.JumpHeight
+	g.jumpLendthRecord = r.JumpLength
+	g.jumpAirtimeRecord = r.JumpAirtime
+	g.trickRecord = r.Tricks
+
+	s := &settings{Muted: true}
+	loadJSON(g.storage, storageKeySettings, s)
+	g.soundIcon.setMuted(s.Muted)
+	if !g.analogTriggerFixed {
+		SetAnalogTriggerDive(s.AnalogTrigger)
+	}
+	g.language = s.Language
+	if s.Language != "" {
+		setLanguage(s.Language)
+	} else {
+		setLanguage(systemLanguage())
+	}
+	g.pack = s.Pack
+	g.loadSavedAssetPack()
+
+	actions.bindings = loadBindings(g.storage)
+	g.loadLeaderboard()
+	g.flushOnline()
+
+	g.achievements = NewAchievements(achievementDefs, g.storage, g.onAchievementUnlocked)
+	g.events.AddListener(g.achievements)
+	g.stats = NewLifetimeStats(g.storage)
+	g.events.AddListener(g.stats)
+}
+
+func (g *Game) onAchievementUnlocked(def AchievementDef) {
+	title := def.Title
+	if id := "achievement." + def.ID; hasMessage(id) {
+		title = tr(id)
+	}
+	g.toast.Show(tr("achievement.unlocked", title))
+	g.newRecordSound.Chime()
+}
+
+// reloadSounds creates the sounds, or recreates them e.g. after an asset pack
+// overrides them.
+func (g *Game) reloadSounds() {
+	if s := g.player.jumpSound; s != nil {
+		if g.player.IsJumping {
+			s.Stop()
+		}
+		if err := s.Close(); err != nil {
+			log.Println(err)
+		}
+	}
+	if g.newRecordSound != nil {
+		if err := g.newRecordSound.Close(); err != nil {
+			log.Println(err)
+		}
+	}
+	for _, e := range g.effects {
+		if err := e.Close(); err != nil {
+			log.Println(err)
+		}
+	}
+	g.player.jumpSound = NewJumpSound(g.sounds)
+	g.newRecordSound = NewNewRecordSound(g.sounds)
+	g.soundIcon.setters = []volumeSetter{g.player.jumpSound, g.newRecordSound}
+	g.effects = map[string]*EffectSound{}
+	for _, name := range []string{soundLand, soundWipeout, soundPickup} {
+		e := NewEffectSound(g.sounds, name)
+		g.effects[name] = e
+		g.soundIcon.setters = append(g.soundIcon.setters, e)
+	}
+	g.soundIcon.setMuted(g.soundIcon.isMuted)
+}
+
+// playEffect plays the sound effect of an event of the player's animation,
+// which is a wipeout, a trick or a plain landing.
+func (g *Game) playEffect(clip, event string) {
+	switch event {
+	case playerEventWipeout:
+		g.effects[soundWipeout].Play()
+	case playerEventLand:
+		if g.player.LastJump.Trick > 0 {
+			g.effects[soundPickup].Play()
+		} else {
+			g.effects[soundLand].Play()
+		}
+	}
+}
+
+// runOnGameLoop makes f run on the game loop. It is safe to call from any
+// goroutine.
+func (g *Game) runOnGameLoop(f func()) {
+	g.tasks <- f
+}
+
+func (g *Game) runTasks() {
+	for {
+		select {
+		case f := <-g.tasks:
+			f()
+		default:
+			return
+		}
+	}
+}
 
-	return &Game{
-		player: &Player{
-			jumpSound: jumpSound,
-		},
-		ground:         &Ground{},
-		soundIcon:      soundIconElem,
-		scale:          1,
-		newRecordSound: newRecordSound,
-	}, nil
+func (g *Game) saveRecords() {
+	saveJSON(g.storage, storageKeyRecords, &records{
+		JumpHeight:  g.jumpHeightRecord,
+		JumpLength:  g.jumpLendthRecord,
+		JumpAirtime: g.jumpAirtimeRecord,
+		Tricks:      g.trickRecord,
+	})
 }
 
 func (g *Game) Update(screen *ebiten.Image) error {
-	g.player.Update(g.ground.At(g.player.x))
-	g.scale = float64(screenHeight) / (g.player.y + playerOffset*4)
-	if g.scale > 1 {
-		g.scale = 1
+	if !builtinAssets.Done() {
+		builtinAssets.Update(loadBudget)
+		if !builtinAssets.Done() {
+			if !ebiten.IsDrawingSkipped() {
+				g.drawLoading(screen)
+			}
+			return nil
+		}
+	}
+	if !g.loaded {
+		g.load()
+		g.loaded = true
+	}
+	g.runTasks()
+
+	input.Update()
+	// UI claims pointers first so that taps on it don't make the player jump.
+	g.ui.Update()
+	// While an action is being rebound, every input goes to it.
+	rebinding := g.rebind != nil
+	if rebinding {
+		g.updateRebind()
+	} else {
+		g.currentFocus().Update()
+	}
+	actions.Update()
+	if !rebinding && !g.isTyping() {
+		g.updateActions()
+	}
+
+	// The sounds stop while the game is paused, and the ticks stop while the
+	// app is suspended.
+	if g.isPaused() {
+		g.sounds.Suspend()
+	} else {
+		g.sounds.Resume()
+		in := playerInput()
+		g.replay.Record(in)
+		gy, grad := g.ground.At(g.player.X)
+		g.player.Update(in, gy, grad)
+		g.events.Update(g.player, g.ground)
+		// Zoom out so that the player stays in the screen, but never zoom in
+		// beyond a world unit per logical pixel.
+		scale := float64(screenHeight) / deviceScale / (g.player.Y + playerOffset*4)
+		if scale > 1 {
+			scale = 1
+		}
+		g.scale = scale * deviceScale
+		g.ground.Update(g.player.X-playerOffset, g.scale)
+		g.updateRecord()
+		g.sounds.Update()
 	}
-	g.ground.Update(g.player.x-playerOffset, g.scale)
-	g.soundIcon.Update()
-	g.updateRecord()
 
 	if ebiten.IsDrawingSkipped() {
 		return nil
 	}
 
-	screen.Fill(color.White)
+	screen.Fill(backgroundColor)
 	g.ground.Draw(screen, g.scale)
 	g.player.Draw(screen, g.scale)
-	g.soundIcon.Draw(screen)
+	g.ui.Draw(screen)
+	g.currentFocus().Draw(screen)
 	g.drawScore(screen)
 
 	// ebitenutil.DebugPrint(screen, fmt.Sprintf("FPS: %0.2f", ebiten.CurrentFPS()))
@@ -128,35 +373,130 @@ func (g *Game) Update(screen *ebiten.Image) error {
 	return nil
 }
 
+func (g *Game) updateActions() {
+	if actions.IsJustPressed(ActionPause) {
+		if g.isPaused() {
+			g.closeMenu()
+		} else {
+			g.openNewMenu(g.newPauseMenu)
+		}
+	}
+	if actions.IsJustPressed(ActionMute) {
+		g.soundIcon.OnClick()
+	}
+	if actions.IsJustPressed(ActionRestart) {
+		g.restart()
+	}
+}
+
+// restart starts a new run in the same mode. The scored attempt of the daily
+// challenge is over, so the new run is practice.
+func (g *Game) restart() {
+	r := g.run
+	if r.mode == ModeDaily {
+		r.practice = true
+	}
+	g.startRun(r)
+}
+
+func (g *Game) startRun(r run) {
+	g.closeAllMenus()
+	g.collectScores(map[Metric]int{
+		MetricDistance: int(g.player.X),
+	})
+	g.submitScores()
+	g.events.EndRun(g.player)
+
+	if g.player.IsJumping {
+		g.player.jumpSound.Stop()
+	}
+	g.player = NewPlayer(g.player.jumpSound, g.playEffect)
+	g.run = r
+	g.ground = NewGround(r.seed())
+	g.replay = physics.NewReplay(g.ground.Seed())
+	g.scale = deviceScale
+	g.newRecordSound.Reset()
+}
+
 func (g *Game) updateRecord() {
-	if h := int(g.player.jumpHeight); h > g.jumpHeightRecord {
+	if h := int(g.player.JumpHeight); h > g.jumpHeightRecord {
 		if h/100 > g.jumpHeightRecord/100 {
 			g.newRecordSound.Update()
 		}
 		g.jumpHeightRecord = h
+		g.recordUpdated = true
 	} else if h == 0 {
 		g.newRecordSound.Reset()
 	}
-	if l := int(g.player.jumpLength); l > g.jumpLendthRecord {
+	if l := int(g.player.JumpLength); l > g.jumpLendthRecord {
 		g.jumpLendthRecord = l
+		g.recordUpdated = true
+	}
+	if t := g.player.JumpTicks; t > g.jumpAirtimeRecord {
+		g.jumpAirtimeRecord = t
+		g.recordUpdated = true
+	}
+	if t := g.player.Tricks; t > g.trickRecord {
+		g.trickRecord = t
+		g.recordUpdated = true
+	}
+	if g.player.Landed {
+		g.collectScores(map[Metric]int{
+			MetricHeight: int(g.player.LastJump.Height),
+			MetricLength: int(g.player.LastJump.Length),
+		})
+	}
+	// Save records once per jump, not on every tick.
+	if g.recordUpdated && !g.player.IsJumping {
+		g.saveRecords()
+		g.recordUpdated = false
 	}
 }
 
 func (g *Game) drawScore(screen *ebiten.Image) {
 	texts := []string{
-		fmt.Sprintf("Height: %6d (%6d)", int(g.player.jumpHeight), g.jumpHeightRecord),
-		fmt.Sprintf("Length: %6d (%6d)", int(g.player.jumpLength), g.jumpLendthRecord),
+		tr("hud.height", int(g.player.JumpHeight), g.jumpHeightRecord),
+		tr("hud.length", int(g.player.JumpLength), g.jumpLendthRecord),
+		tr("hud.airtime", ticksToSeconds(g.player.JumpTicks), ticksToSeconds(g.jumpAirtimeRecord)),
+		tr("hud.tricks", g.player.Tricks, g.trickRecord),
 	}
-	for i, t := range texts {
-		x := screenWidth - fontSize*len(t)
-		y := fontSize*(i+1) + iconSize
-		text.Draw(screen, t, arcadeFont, x, y, color.Black)
+	if g.run.mode == ModeDaily {
+		id := "hud.daily"
+		if g.run.practice {
+			id = "hud.practice"
+		}
+		texts = append(texts, tr(id, g.run.date))
+	}
+	y := dp(iconSize)
+	for _, t := range texts {
+		w, h := textSize(uiFont, t)
+		drawText(screen, t, uiFont, screenWidth-w, y, h, color.Black)
+		y += h
 	}
 }
 
+// setDeviceScale updates the sizes in device pixels, e.g. when the window
+// moves to a display of another density.
+func (g *Game) setDeviceScale(s float64) {
+	g.scale *= s / deviceScale
+	deviceScale = s
+	uiFont = uiFace(fontSize)
+	g.soundIconElem.SetSize(dp(iconSize), dp(iconSize))
+}
+
+func ticksToSeconds(ticks int) float64 {
+	return float64(ticks) / float64(ebiten.MaxTPS())
+}
+
 func (g *Game) Layout(outsideWidth, outsideHeight int) (w, h int) {
-	screenWidth = outsideWidth
-	screenHeight = outsideHeight
-	g.soundIcon.SetPosition(screenWidth-iconSize, 0)
+	// The outside size is in logical pixels. Render at the native resolution
+	// of the display.
+	s := ebiten.DeviceScaleFactor()
+	if s != deviceScale {
+		g.setDeviceScale(s)
+	}
+	screenWidth = int(math.Ceil(float64(outsideWidth) * s))
+	screenHeight = int(math.Ceil(float64(outsideHeight) * s))
+	g.ui.SetSize(screenWidth, screenHeight)
 	return screenWidth, screenHeight
 }
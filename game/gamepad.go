@@ -0,0 +1,107 @@
+package game
+
+import "github.com/hajimehoshi/ebiten"
+
+// Gamepad buttons in the standard layout of the browsers' Gamepad API, which
+// most controllers follow on desktop too.
+const (
+	gamepadButtonA     = ebiten.GamepadButton0
+	gamepadButtonB     = ebiten.GamepadButton1
+	gamepadButtonBack  = ebiten.GamepadButton8
+	gamepadButtonStart = ebiten.GamepadButton9
+	gamepadButtonUp    = ebiten.GamepadButton12
+	gamepadButtonDown  = ebiten.GamepadButton13
+	gamepadButtonLeft  = ebiten.GamepadButton14
+	gamepadButtonRight = ebiten.GamepadButton15
+)
+
+// Face buttons, shoulder buttons and triggers in the standard layout except
+// B, which rotates.
+var gamepadDiveButtons = []ebiten.GamepadButton{
+	ebiten.GamepadButton0,
+	ebiten.GamepadButton2,
+	ebiten.GamepadButton3,
+	ebiten.GamepadButton4,
+	ebiten.GamepadButton5,
+	ebiten.GamepadButton6,
+	ebiten.GamepadButton7,
+}
+
+// Axes of the analog triggers of XInput controllers on desktop. They rest at
+// -1 and reach 1 when fully pressed.
+var gamepadTriggerAxes = []int{4, 5}
+
+const triggerDeadZone = 0.05
+
+type gamepadButtonKey struct {
+	id     int
+	button ebiten.GamepadButton
+}
+
+// gamepadInput reads the buttons of all the connected gamepads. Gamepads are
+// polled every tick, so ones connected mid-game just work.
+type gamepadInput struct {
+	// analogTrigger makes the depth of the analog triggers scale the dive.
+	// It is off by default since some gamepads use the same axes for a stick.
+	analogTrigger bool
+
+	consumed map[gamepadButtonKey]bool
+}
+
+var gamepad = &gamepadInput{
+	consumed: map[gamepadButtonKey]bool{},
+}
+
+// SetAnalogTriggerDive sets whether the depth of the analog triggers scales
+// the dive gravity.
+func SetAnalogTriggerDive(enabled bool) {
+	gamepad.analogTrigger = enabled
+}
+
+func (g *gamepadInput) Update() {
+	for k := range g.consumed {
+		if !ebiten.IsGamepadButtonPressed(k.id, k.button) {
+			delete(g.consumed, k)
+		}
+	}
+}
+
+// IsButtonPressed reports whether the button is pressed on any gamepad,
+// ignoring the consumed ones.
+func (g *gamepadInput) IsButtonPressed(button ebiten.GamepadButton) bool {
+	for _, id := range ebiten.GamepadIDs() {
+		if ebiten.IsGamepadButtonPressed(id, button) && !g.consumed[gamepadButtonKey{id, button}] {
+			return true
+		}
+	}
+	return false
+}
+
+// TriggerDepth returns how deep the analog triggers are pressed in [0, 1].
+// It is always 0 unless the analog triggers are enabled.
+func (g *gamepadInput) TriggerDepth() float64 {
+	if !g.analogTrigger {
+		return 0
+	}
+	depth := 0.0
+	for _, id := range ebiten.GamepadIDs() {
+		for _, a := range gamepadTriggerAxes {
+			if a >= ebiten.GamepadAxisNum(id) {
+				continue
+			}
+			if d := (ebiten.GamepadAxis(id, a) + 1) / 2; d > depth {
+				depth = d
+			}
+		}
+	}
+	if depth < triggerDeadZone {
+		return 0
+	}
+	return depth
+}
+
+// Consume keeps the button from triggering actions until it is released,
+// e.g. when it activated a UI element.
+func (g *gamepadInput) Consume(id int, button ebiten.GamepadButton) {
+	g.consumed[gamepadButtonKey{id, button}] = true
+}
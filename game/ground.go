@@ -1,19 +1,13 @@
 package game
 
 import (
+	"image"
 	"image/color"
+	"image/draw"
 	"math"
-	"math/rand"
 
 	"github.com/hajimehoshi/ebiten"
-)
-
-const (
-	groundY           = 16
-	minMountainWidth  = 200
-	minMountainHeight = 100
-	maxMountainWidth  = 500
-	maxMountainHeight = 300
+	"github.com/hiroebe/osushi/physics"
 )
 
 var (
@@ -21,123 +15,91 @@ var (
 	undergroundBaseImg  *ebiten.Image
 	surfaceColorBaseImg *ebiten.Image
 
+	backgroundColor    = color.NRGBA{0xff, 0xff, 0xff, 0xff}
 	groundSurfaceColor = color.NRGBA{0x00, 0x99, 0x00, 0xff}
 	undergroundColor1  = color.NRGBA{0xcc, 0x99, 0x00, 0xff}
 	undergroundColor2  = color.NRGBA{0x99, 0x66, 0x00, 0xff}
 )
 
-func init() {
-	initMountainBaseImg()
-	initGroundBaseImg()
-	surfaceColorBaseImg, _ = ebiten.NewImage(1, 1, ebiten.FilterDefault)
-	surfaceColorBaseImg.Fill(groundSurfaceColor)
+// initGroundImages draws the pattern tiles in the current colors into their
+// areas of the atlas.
+func initGroundImages() error {
+	surface := image.NewNRGBA(image.Rect(0, 0, 1, 1))
+	surface.SetNRGBA(0, 0, groundSurfaceColor)
+	if err := putGroundImage(&mountainBaseImg, "mountain", mountainPattern()); err != nil {
+		return err
+	}
+	if err := putGroundImage(&undergroundBaseImg, "underground", undergroundPattern()); err != nil {
+		return err
+	}
+	return putGroundImage(&surfaceColorBaseImg, "surface", surface)
+}
+
+// putGroundImage puts src into the atlas as *dst. If it fails, *dst is kept,
+// or is the placeholder if it has never been put.
+func putGroundImage(dst **ebiten.Image, name string, src image.Image) error {
+	img, err := builtinAtlas.Put(name, src)
+	if err != nil {
+		if *dst == nil {
+			*dst = placeholderImage
+		}
+		return err
+	}
+	*dst = img
+	return nil
 }
 
-func initMountainBaseImg() {
+func mountainPattern() image.Image {
 	const size = 512
-	mountainBaseImg, _ = ebiten.NewImage(size, size, ebiten.FilterDefault)
+	img := image.NewNRGBA(image.Rect(0, 0, size, size))
 	for x := 0; x < size; x++ {
 		y := int(float64(size) / 2 * (1 + math.Cos(2*math.Pi/float64(size)*float64(x))))
 		for y := y; y < size; y++ {
-			mountainBaseImg.Set(x, y, groundSurfaceColor)
+			img.SetNRGBA(x, y, groundSurfaceColor)
 		}
 	}
+	return img
 }
 
-func initGroundBaseImg() {
+func undergroundPattern() image.Image {
 	const (
 		size     = 128
 		cellSize = 32
 		dotSize  = 8
 	)
-	undergroundBaseImg, _ = ebiten.NewImage(size, size, ebiten.FilterDefault)
-	undergroundBaseImg.Fill(undergroundColor1)
-	groundDotImg, _ := ebiten.NewImage(dotSize, dotSize, ebiten.FilterDefault)
-	groundDotImg.Fill(undergroundColor2)
+	img := image.NewNRGBA(image.Rect(0, 0, size, size))
+	draw.Draw(img, img.Bounds(), image.NewUniform(undergroundColor1), image.ZP, draw.Src)
+	dot := image.NewUniform(undergroundColor2)
 	for i := 0; i < size/cellSize; i++ {
 		for j := 0; j < size/cellSize; j++ {
-			centerX := float64(cellSize*i + cellSize/2)
-			centerY := float64(cellSize*j + cellSize/2)
-			opts := &ebiten.DrawImageOptions{}
-			opts.GeoM.Translate(centerX-dotSize, centerY-dotSize)
-			undergroundBaseImg.DrawImage(groundDotImg, opts)
+			centerX := cellSize*i + cellSize/2
+			centerY := cellSize*j + cellSize/2
+			r := image.Rect(centerX-dotSize, centerY-dotSize, centerX, centerY)
+			draw.Draw(img, r, dot, image.ZP, draw.Src)
 		}
 	}
-}
-
-type Mountain struct {
-	startX        float64
-	width, height float64
-}
-
-func NewRandomMountain(startX float64) *Mountain {
-	width := minMountainWidth + rand.Float64()*(maxMountainWidth-minMountainWidth)
-	height := minMountainHeight + rand.Float64()*(maxMountainHeight-minMountainHeight)
-	return &Mountain{startX: startX, width: width, height: height}
-}
-
-func (m *Mountain) StartX() float64 {
-	return m.startX
-}
-
-func (m *Mountain) EndX() float64 {
-	return m.startX + m.width
-}
-
-func (m *Mountain) TopX() float64 {
-	return m.startX + m.width/2
-}
-
-func (m *Mountain) Width() float64 {
-	return m.width
-}
-
-func (m *Mountain) Height() float64 {
-	return m.height
-}
-
-func (m *Mountain) At(x float64) (y, grad float64) {
-	x -= m.StartX()
-	y = groundY + m.Height()/2*(1-math.Cos(2*math.Pi/m.Width()*x))
-	grad = m.Height() / m.Width() * math.Pi * math.Sin(2*math.Pi/m.Width()*x)
-	return y, grad
+	return img
 }
 
 type Ground struct {
-	mountains []*Mountain
-	screenX   float64
-	img       *ebiten.Image
+	*physics.Terrain
+
+	screenX float64
+	img     *ebiten.Image
 }
 
-func (g *Ground) At(x float64) (y, grad float64) {
-	for _, m := range g.mountains {
-		if x >= m.StartX() && x <= m.EndX() {
-			return m.At(x)
-		}
+// NewGround creates a ground whose mountains are generated from seed. The same
+// seed always gives the same course.
+func NewGround(seed int64) *Ground {
+	return &Ground{
+		Terrain: physics.NewTerrain(seed),
 	}
-	return 0, 0
 }
 
 func (g *Ground) Update(screenX, scale float64) {
 	g.screenX = screenX
-	if g.mountains == nil {
-		g.mountains = make([]*Mountain, 0, 64)
-	}
-	if len(g.mountains) == 0 {
-		m := &Mountain{startX: -maxMountainWidth / 2, width: maxMountainWidth, height: maxMountainHeight}
-		g.mountains = append(g.mountains, m)
-	}
-	if g.mountains[0].EndX() < screenX {
-		copy(g.mountains, g.mountains[1:])
-		g.mountains = g.mountains[:len(g.mountains)-1]
-	}
-	for {
-		lastX := g.mountains[len(g.mountains)-1].EndX()
-		if lastX >= float64(screenWidth)/scale+screenX {
-			break
-		}
-		g.mountains = append(g.mountains, NewRandomMountain(lastX))
-	}
+	g.Trim(screenX)
+	g.Extend(float64(screenWidth)/scale + screenX)
 }
 
 func (g *Ground) Draw(screen *ebiten.Image, scale float64) {
@@ -156,7 +118,7 @@ func (g *Ground) Draw(screen *ebiten.Image, scale float64) {
 }
 
 func (g *Ground) drawUnderground(dstImg *ebiten.Image, scale float64) {
-	for _, m := range g.mountains {
+	for _, m := range g.Mountains() {
 		g.drawMountain(dstImg, m, scale, 0.8, 0)
 	}
 }
@@ -186,19 +148,19 @@ func (g *Ground) drawGroundPattern(dstImg *ebiten.Image, scale float64) {
 }
 
 func (g *Ground) drawGroundSurface(dstImg *ebiten.Image, scale float64) {
-	y := float64(screenHeight) - groundY*scale
+	y := float64(screenHeight) - physics.GroundY*scale
 	opts := &ebiten.DrawImageOptions{}
 	opts.CompositeMode = ebiten.CompositeModeDestinationOver
-	opts.GeoM.Scale(float64(screenWidth), groundY*scale)
+	opts.GeoM.Scale(float64(screenWidth), physics.GroundY*scale)
 	opts.GeoM.Translate(0, y)
 	dstImg.DrawImage(surfaceColorBaseImg, opts)
 
-	for _, m := range g.mountains {
-		g.drawMountain(dstImg, m, scale, 1, groundY)
+	for _, m := range g.Mountains() {
+		g.drawMountain(dstImg, m, scale, 1, physics.GroundY)
 	}
 }
 
-func (g *Ground) drawMountain(dstImg *ebiten.Image, m *Mountain, scale, mtScale, offsetY float64) {
+func (g *Ground) drawMountain(dstImg *ebiten.Image, m *physics.Mountain, scale, mtScale, offsetY float64) {
 	w, h := mountainBaseImg.Size()
 	x := (m.StartX()-g.screenX)*scale + m.Width()*(1-mtScale)*scale/2
 	y := float64(screenHeight) - offsetY*scale - m.Height()*scale*mtScale
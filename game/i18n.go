@@ -0,0 +1,236 @@
+package game
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Language is a language of the text in the game.
+type Language string
+
+const (
+	LanguageEnglish  Language = "en"
+	LanguageJapanese Language = "ja"
+)
+
+// languages are the supported languages in the order of the switch in the
+// settings menu.
+var languages = []Language{LanguageEnglish, LanguageJapanese}
+
+var languageNames = map[Language]string{
+	LanguageEnglish:  "ENGLISH",
+	LanguageJapanese: "日本語",
+}
+
+// catalogs are the messages of each language by their IDs. Messages missing
+// in a language fall back to English.
+var catalogs = map[Language]map[string]string{
+	LanguageEnglish: {
+		"hud.height":   "Height: %6d (%6d)",
+		"hud.length":   "Length: %6d (%6d)",
+		"hud.airtime":  "Air(s): %6.2f (%6.2f)",
+		"hud.tricks":   "Tricks: %6d (%6d)",
+		"hud.daily":    "Daily %s",
+		"hud.practice": "Practice %s",
+
+		"menu.paused":      "PAUSED",
+		"menu.resume":      "RESUME",
+		"menu.settings":    "SETTINGS",
+		"menu.daily":       "DAILY",
+		"menu.leaderboard": "LEADERBOARD",
+		"menu.stats":       "STATS",
+		"menu.restart":     "RESTART",
+		"menu.back":        "BACK",
+		"menu.ok":          "OK",
+
+		"settings.pressAnyInput": "PRESS ANY INPUT",
+		"settings.analogTrigger": "ANALOG TRIGGER",
+		"settings.reset":         "RESET",
+		"settings.language":      "LANGUAGE",
+		"settings.pack":          "SKIN",
+		"settings.packDefault":   "DEFAULT",
+		"settings.mouse":         "MOUSE %s",
+		"settings.pad":           "PAD %d",
+
+		"action.dive":    "DIVE",
+		"action.jump":    "JUMP",
+		"action.pause":   "PAUSE",
+		"action.mute":    "MUTE",
+		"action.restart": "RESTART",
+		"action.rotate":  "ROTATE",
+
+		"metric.height":   "HEIGHT",
+		"metric.length":   "LENGTH",
+		"metric.distance": "DISTANCE",
+
+		"leaderboard.newRecord": "NEW RECORD!",
+		"leaderboard.loading":   "LOADING",
+		"leaderboard.offline":   "OFFLINE",
+		"leaderboard.noEntries": "NO ENTRIES",
+		"leaderboard.daily":     "DAILY",
+		"leaderboard.online":    "ONLINE",
+
+		"daily.title":     "DAILY %s",
+		"daily.triesLeft": "1 SCORED TRY LEFT",
+		"daily.played":    "PLAYED TODAY",
+		"daily.challenge": "CHALLENGE",
+		"daily.practice":  "PRACTICE",
+		"daily.normal":    "NORMAL",
+
+		"stats.runs":        "RUNS       %8d",
+		"stats.jumps":       "JUMPS      %8d",
+		"stats.distance":    "DISTANCE   %8d",
+		"stats.airtime":     "AIRTIME    %7.1fS",
+		"stats.bestCombo":   "BEST COMBO %8d",
+		"stats.avgHeight":   "AVG HEIGHT %8d",
+		"stats.jumpHeights": "JUMP HEIGHTS",
+
+		"loading.failed": "SOME ASSETS FAILED TO LOAD",
+
+		"achievement.unlocked":     "ACHIEVEMENT: %s",
+		"achievement.mountains-10": "MOUNTAIN HOPPER",
+		"achievement.height-5000":  "SKY HIGH",
+		"achievement.perfect-100":  "SMOOTH LANDER",
+		"achievement.airtime-30":   "FREQUENT FLYER",
+	},
+	LanguageJapanese: {
+		"hud.height":   "高さ: %6d (%6d)",
+		"hud.length":   "距離: %6d (%6d)",
+		"hud.airtime":  "滞空: %6.2f秒 (%6.2f秒)",
+		"hud.tricks":   "トリック: %6d (%6d)",
+		"hud.daily":    "デイリー %s",
+		"hud.practice": "練習 %s",
+
+		"menu.paused":      "ポーズ",
+		"menu.resume":      "再開",
+		"menu.settings":    "設定",
+		"menu.daily":       "デイリー",
+		"menu.leaderboard": "ランキング",
+		"menu.stats":       "統計",
+		"menu.restart":     "リスタート",
+		"menu.back":        "戻る",
+		"menu.ok":          "OK",
+
+		"settings.pressAnyInput": "入力してください",
+		"settings.analogTrigger": "アナログトリガー",
+		"settings.reset":         "リセット",
+		"settings.language":      "言語",
+		"settings.pack":          "スキン",
+		"settings.packDefault":   "標準",
+		"settings.mouse":         "マウス %s",
+		"settings.pad":           "パッド %d",
+
+		"action.dive":    "ダイブ",
+		"action.jump":    "ジャンプ",
+		"action.pause":   "ポーズ",
+		"action.mute":    "ミュート",
+		"action.restart": "リスタート",
+		"action.rotate":  "回転",
+
+		"metric.height":   "高さ",
+		"metric.length":   "飛距離",
+		"metric.distance": "走行距離",
+
+		"leaderboard.newRecord": "新記録!",
+		"leaderboard.loading":   "読み込み中",
+		"leaderboard.offline":   "オフライン",
+		"leaderboard.noEntries": "記録なし",
+		"leaderboard.daily":     "デイリー",
+		"leaderboard.online":    "オンライン",
+
+		"daily.title":     "デイリー %s",
+		"daily.triesLeft": "本番 残り1回",
+		"daily.played":    "本日プレイ済み",
+		"daily.challenge": "挑戦",
+		"daily.practice":  "練習",
+		"daily.normal":    "通常モード",
+
+		"stats.runs":        "プレイ回数 %8d",
+		"stats.jumps":       "ジャンプ数 %8d",
+		"stats.distance":    "総走行距離 %8d",
+		"stats.airtime":     "総滞空時間 %7.1f秒",
+		"stats.bestCombo":   "最大コンボ %8d",
+		"stats.avgHeight":   "平均の高さ %8d",
+		"stats.jumpHeights": "ジャンプの高さ",
+
+		"loading.failed": "一部の素材を読み込めませんでした",
+
+		"achievement.unlocked":     "実績解除: %s",
+		"achievement.mountains-10": "山飛び名人",
+		"achievement.height-5000":  "天まで届け",
+		"achievement.perfect-100":  "着地の達人",
+		"achievement.airtime-30":   "空の常連",
+	},
+}
+
+// language is the current language. It is changed only on the game loop.
+var language = LanguageEnglish
+
+func setLanguage(l Language) {
+	language = l
+}
+
+// tr returns the message of id in the current language, formatted with args
+// if any.
+func tr(id string, args ...interface{}) string {
+	msg, ok := catalogs[language][id]
+	if !ok {
+		msg, ok = catalogs[LanguageEnglish][id]
+	}
+	if !ok {
+		return id
+	}
+	if len(args) == 0 {
+		return msg
+	}
+	return fmt.Sprintf(msg, args...)
+}
+
+// hasMessage reports whether id is in the English catalog, which has every
+// message.
+func hasMessage(id string) bool {
+	_, ok := catalogs[LanguageEnglish][id]
+	return ok
+}
+
+var (
+	hostLocale   string
+	hostLocaleMu sync.Mutex
+)
+
+// SetSystemLocale sets the locale of the platform such as "ja-JP", for hosts
+// which know it better than the game, e.g. mobile apps. The language follows
+// it unless the player chooses one in the settings.
+func SetSystemLocale(locale string) {
+	hostLocaleMu.Lock()
+	defer hostLocaleMu.Unlock()
+	hostLocale = locale
+}
+
+// systemLanguage returns the supported language matching the locale of the
+// platform, or English.
+func systemLanguage() Language {
+	hostLocaleMu.Lock()
+	locale := hostLocale
+	hostLocaleMu.Unlock()
+	if locale == "" {
+		locale = detectLocale()
+	}
+	return languageFromLocale(locale)
+}
+
+// languageFromLocale returns the language of a locale in any of the forms of
+// the platforms, e.g. "ja_JP.UTF-8" or "ja-JP".
+func languageFromLocale(locale string) Language {
+	tag := strings.ToLower(locale)
+	if i := strings.IndexAny(tag, "-_."); i >= 0 {
+		tag = tag[:i]
+	}
+	for _, l := range languages {
+		if string(l) == tag {
+			return l
+		}
+	}
+	return LanguageEnglish
+}
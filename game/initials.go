@@ -0,0 +1,103 @@
+package game
+
+import (
+	"strings"
+
+	"github.com/hajimehoshi/ebiten"
+	"github.com/hajimehoshi/ebiten/inpututil"
+)
+
+const (
+	initialsLen   = 3
+	initialsChars = "ABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+)
+
+// initialsInput lets the player enter initials arcade style. Each letter is
+// changed with the buttons above and below it, or typed on a keyboard.
+type initialsInput struct {
+	*Box
+
+	letters [initialsLen]int
+	labels  [initialsLen]*Label
+	ups     []Element
+	downs   []Element
+	cursor  int
+}
+
+func newInitialsInput(name string) *initialsInput {
+	i := &initialsInput{
+		Box: NewHBox(menuSpacing),
+	}
+	for n := 0; n < initialsLen; n++ {
+		n := n
+		if n < len(name) {
+			if idx := strings.IndexByte(initialsChars, name[n]); idx >= 0 {
+				i.letters[n] = idx
+			}
+		}
+		i.labels[n] = &Label{}
+		up := NewButton("+", func() {
+			i.cursor = n
+			i.shift(n, 1)
+		})
+		down := NewButton("-", func() {
+			i.cursor = n
+			i.shift(n, -1)
+		})
+		i.ups = append(i.ups, up)
+		i.downs = append(i.downs, down)
+		i.Box.Add(NewVBox(menuSpacing/2, up, NewElement(i.labels[n]), down))
+	}
+	i.updateLabels()
+	return i
+}
+
+func (i *initialsInput) Update() {
+	for _, r := range ebiten.InputChars() {
+		idx := strings.IndexRune(initialsChars, r)
+		if idx < 0 {
+			idx = strings.IndexRune(initialsChars, r-'a'+'A')
+		}
+		if idx < 0 {
+			continue
+		}
+		i.letters[i.cursor] = idx
+		i.cursor = (i.cursor + 1) % initialsLen
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyBackspace) {
+		i.cursor = (i.cursor + initialsLen - 1) % initialsLen
+	}
+	i.updateLabels()
+	i.Box.Update()
+}
+
+func (i *initialsInput) shift(n, delta int) {
+	i.letters[n] = (i.letters[n] + delta + len(initialsChars)) % len(initialsChars)
+}
+
+func (i *initialsInput) updateLabels() {
+	for n, l := range i.labels {
+		l.Text = string(initialsChars[i.letters[n]])
+		l.Color = widgetBorderColor
+		if n == i.cursor {
+			l.Color = widgetActiveColor
+		}
+	}
+}
+
+func (i *initialsInput) Name() string {
+	var b strings.Builder
+	for _, idx := range i.letters {
+		b.WriteByte(initialsChars[idx])
+	}
+	return b.String()
+}
+
+// Focusables returns the buttons in the tab order.
+func (i *initialsInput) Focusables() []Element {
+	var elems []Element
+	for n := range i.ups {
+		elems = append(elems, i.ups[n], i.downs[n])
+	}
+	return elems
+}
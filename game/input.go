@@ -0,0 +1,126 @@
+package game
+
+import (
+	"sort"
+
+	"github.com/hajimehoshi/ebiten"
+	"github.com/hajimehoshi/ebiten/inpututil"
+)
+
+// mousePointerID is the pointer ID of the left mouse button. Touch IDs given by
+// Ebiten are never negative.
+const mousePointerID = -1
+
+// Pointer is a mouse button or a touch which is pressed, or was released in
+// the current tick.
+type Pointer struct {
+	ID           int
+	X, Y         int
+	JustPressed  bool
+	JustReleased bool
+
+	owner interface{}
+}
+
+// Claim makes owner receive the pointer until it is released. A claimed
+// pointer is not seen by gameplay. It reports whether owner holds the pointer.
+func (p *Pointer) Claim(owner interface{}) bool {
+	if p.owner == nil {
+		p.owner = owner
+	}
+	return p.owner == owner
+}
+
+func (p *Pointer) IsClaimed() bool {
+	return p.owner != nil
+}
+
+func (p *Pointer) IsClaimedBy(owner interface{}) bool {
+	return p.owner == owner
+}
+
+// inputDispatcher tracks every pointer for a tick. UI elements hit-test and
+// claim pointers in their Update, which runs before the player's, and only
+// unclaimed input reaches gameplay.
+type inputDispatcher struct {
+	pointers map[int]*Pointer
+	sorted   []*Pointer
+}
+
+var input = &inputDispatcher{
+	pointers: map[int]*Pointer{},
+}
+
+func (d *inputDispatcher) Update() {
+	for id, p := range d.pointers {
+		if p.JustReleased {
+			delete(d.pointers, id)
+			continue
+		}
+		p.JustPressed = false
+	}
+
+	if inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonLeft) {
+		d.pointers[mousePointerID] = &Pointer{ID: mousePointerID, JustPressed: true}
+	}
+	if p, ok := d.pointers[mousePointerID]; ok {
+		p.X, p.Y = ebiten.CursorPosition()
+		if !ebiten.IsMouseButtonPressed(ebiten.MouseButtonLeft) {
+			p.JustReleased = true
+		}
+	}
+
+	for _, id := range inpututil.JustPressedTouchIDs() {
+		d.pointers[id] = &Pointer{ID: id, JustPressed: true}
+	}
+	for id, p := range d.pointers {
+		if id == mousePointerID {
+			continue
+		}
+		if inpututil.IsTouchJustReleased(id) {
+			// The position of a released touch is not available any more.
+			p.JustReleased = true
+			continue
+		}
+		p.X, p.Y = ebiten.TouchPosition(id)
+	}
+
+	d.sorted = d.sorted[:0]
+	for _, p := range d.pointers {
+		d.sorted = append(d.sorted, p)
+	}
+	sort.Slice(d.sorted, func(i, j int) bool {
+		return d.sorted[i].ID < d.sorted[j].ID
+	})
+
+	gamepad.Update()
+}
+
+// Pointers returns the pointers in the current tick ordered by ID.
+func (d *inputDispatcher) Pointers() []*Pointer {
+	return d.sorted
+}
+
+// IsMousePressed reports whether the left mouse button is pressed and not
+// claimed by UI.
+func (d *inputDispatcher) IsMousePressed() bool {
+	p, ok := d.pointers[mousePointerID]
+	return ok && !p.IsClaimed() && !p.JustReleased
+}
+
+// IsMouseClaimed reports whether the left mouse button is claimed by UI.
+func (d *inputDispatcher) IsMouseClaimed() bool {
+	p, ok := d.pointers[mousePointerID]
+	return ok && p.IsClaimed()
+}
+
+// IsTouchPressed reports whether any touch which is not claimed by UI is
+// pressed.
+func (d *inputDispatcher) IsTouchPressed() bool {
+	for _, p := range d.sorted {
+		if p.ID != mousePointerID && !p.IsClaimed() && !p.JustReleased {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,291 @@
+package game
+
+import (
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten"
+	"github.com/hajimehoshi/ebiten/ebitenutil"
+)
+
+// Box is an Element which arranges its children in a column or in a row.
+// Children are centered on the cross axis.
+type Box struct {
+	children []Element
+	vertical bool
+	// spacing is in logical pixels.
+	spacing int
+
+	x, y int
+	w, h int
+}
+
+func NewVBox(spacing int, children ...Element) *Box {
+	return &Box{children: children, vertical: true, spacing: spacing}
+}
+
+func NewHBox(spacing int, children ...Element) *Box {
+	return &Box{children: children, spacing: spacing}
+}
+
+func (b *Box) Add(children ...Element) {
+	b.children = append(b.children, children...)
+	b.layout()
+}
+
+func (b *Box) Clear() {
+	b.children = nil
+}
+
+func (b *Box) Children() []Element {
+	return b.children
+}
+
+func (b *Box) Update() {
+	for _, c := range b.children {
+		c.Update()
+	}
+	// Children may change their sizes, e.g. when the text of a label changes.
+	b.layout()
+}
+
+func (b *Box) Draw(screen *ebiten.Image) {
+	for _, c := range b.children {
+		c.Draw(screen)
+	}
+}
+
+func (b *Box) SetPosition(x, y int) {
+	b.x = x
+	b.y = y
+	b.layout()
+}
+
+func (b *Box) Position() (x, y int) {
+	return b.x, b.y
+}
+
+func (b *Box) SetSize(w, h int) {
+	b.w = w
+	b.h = h
+	b.layout()
+}
+
+func (b *Box) Size() (w, h int) {
+	cw, ch := b.contentSize()
+	if b.w > cw {
+		cw = b.w
+	}
+	if b.h > ch {
+		ch = b.h
+	}
+	return cw, ch
+}
+
+func (b *Box) contentSize() (w, h int) {
+	for i, c := range b.children {
+		cw, ch := c.Size()
+		if b.vertical {
+			if cw > w {
+				w = cw
+			}
+			h += ch
+		} else {
+			if ch > h {
+				h = ch
+			}
+			w += cw
+		}
+		if i > 0 {
+			if b.vertical {
+				h += dp(b.spacing)
+			} else {
+				w += dp(b.spacing)
+			}
+		}
+	}
+	return w, h
+}
+
+func (b *Box) layout() {
+	w, h := b.Size()
+	x, y := b.x, b.y
+	for _, c := range b.children {
+		cw, ch := c.Size()
+		if b.vertical {
+			c.SetPosition(x+(w-cw)/2, y)
+			y += ch + dp(b.spacing)
+		} else {
+			c.SetPosition(x, y+(h-ch)/2)
+			x += cw + dp(b.spacing)
+		}
+	}
+}
+
+// Panel is an Element which draws a framed background behind its child.
+type Panel struct {
+	child Element
+	// padding is in logical pixels.
+	padding    int
+	background color.Color
+
+	x, y int
+}
+
+func NewPanel(child Element) *Panel {
+	return &Panel{
+		child:      child,
+		padding:    widgetPadding * 2,
+		background: widgetBackgroundColor,
+	}
+}
+
+func (p *Panel) Update() {
+	p.child.Update()
+	// Swallow the pointers on the background so that they don't reach gameplay.
+	w, h := p.Size()
+	for _, ptr := range input.Pointers() {
+		if ptr.JustPressed && ptr.X >= p.x && ptr.X < p.x+w && ptr.Y >= p.y && ptr.Y < p.y+h {
+			ptr.Claim(p)
+		}
+	}
+}
+
+func (p *Panel) Draw(screen *ebiten.Image) {
+	w, h := p.Size()
+	ebitenutil.DrawRect(screen, float64(p.x), float64(p.y), float64(w), float64(h), widgetBorderColor)
+	ebitenutil.DrawRect(screen, float64(p.x+1), float64(p.y+1), float64(w-2), float64(h-2), p.background)
+	p.child.Draw(screen)
+}
+
+func (p *Panel) SetPosition(x, y int) {
+	p.x = x
+	p.y = y
+	p.child.SetPosition(x+dp(p.padding), y+dp(p.padding))
+}
+
+func (p *Panel) Position() (x, y int) {
+	return p.x, p.y
+}
+
+func (p *Panel) SetSize(w, h int) {
+	p.child.SetSize(w-dp(p.padding)*2, h-dp(p.padding)*2)
+}
+
+func (p *Panel) Size() (w, h int) {
+	w, h = p.child.Size()
+	return w + dp(p.padding)*2, h + dp(p.padding)*2
+}
+
+type Anchor int
+
+const (
+	AnchorTopLeft Anchor = iota
+	AnchorTop
+	AnchorTopRight
+	AnchorLeft
+	AnchorCenter
+	AnchorRight
+	AnchorBottomLeft
+	AnchorBottom
+	AnchorBottomRight
+)
+
+type anchored struct {
+	elem             Element
+	anchor           Anchor
+	offsetX, offsetY int
+}
+
+// AnchorLayout is an Element which places each child at one of the nine
+// anchor points of its own area. It is meant to cover the whole screen and
+// to be resized in Game.Layout.
+type AnchorLayout struct {
+	children []*anchored
+
+	x, y int
+	w, h int
+}
+
+func NewAnchorLayout() *AnchorLayout {
+	return &AnchorLayout{}
+}
+
+// Add adds elem at anchor. The offset in logical pixels moves elem toward the
+// center of the area for the anchors at the edges.
+func (l *AnchorLayout) Add(elem Element, anchor Anchor, offsetX, offsetY int) {
+	l.children = append(l.children, &anchored{
+		elem:    elem,
+		anchor:  anchor,
+		offsetX: offsetX,
+		offsetY: offsetY,
+	})
+	l.layout()
+}
+
+func (l *AnchorLayout) Remove(elem Element) {
+	for i, c := range l.children {
+		if c.elem == elem {
+			l.children = append(l.children[:i], l.children[i+1:]...)
+			return
+		}
+	}
+}
+
+func (l *AnchorLayout) Update() {
+	// Children added later are drawn on top, so they hit-test first.
+	for i := len(l.children) - 1; i >= 0; i-- {
+		l.children[i].elem.Update()
+	}
+	l.layout()
+}
+
+func (l *AnchorLayout) Draw(screen *ebiten.Image) {
+	for _, c := range l.children {
+		c.elem.Draw(screen)
+	}
+}
+
+func (l *AnchorLayout) SetPosition(x, y int) {
+	l.x = x
+	l.y = y
+	l.layout()
+}
+
+func (l *AnchorLayout) Position() (x, y int) {
+	return l.x, l.y
+}
+
+func (l *AnchorLayout) SetSize(w, h int) {
+	l.w = w
+	l.h = h
+	l.layout()
+}
+
+func (l *AnchorLayout) Size() (w, h int) {
+	return l.w, l.h
+}
+
+func (l *AnchorLayout) layout() {
+	for _, c := range l.children {
+		w, h := c.elem.Size()
+		ox, oy := dp(c.offsetX), dp(c.offsetY)
+		x, y := l.x, l.y
+		switch c.anchor % 3 {
+		case 0:
+			x += ox
+		case 1:
+			x += (l.w-w)/2 + ox
+		case 2:
+			x += l.w - w - ox
+		}
+		switch c.anchor / 3 {
+		case 0:
+			y += oy
+		case 1:
+			y += (l.h-h)/2 + oy
+		case 2:
+			y += l.h - h - oy
+		}
+		c.elem.SetPosition(x, y)
+	}
+}
@@ -0,0 +1,475 @@
+package game
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/hiroebe/osushi/leaderboard"
+	"github.com/hiroebe/osushi/physics"
+)
+
+const (
+	leaderboardSize = 10
+
+	storageKeyLeaderboard = "leaderboard"
+
+	ModeNormal = leaderboard.ModeNormal
+	ModeDaily  = leaderboard.ModeDaily
+)
+
+// Metric is what a leaderboard ranks.
+type Metric int
+
+const (
+	MetricHeight Metric = iota
+	MetricLength
+	MetricDistance
+	metricNum
+)
+
+var metricNames = [...]string{
+	MetricHeight:   leaderboard.MetricHeight,
+	MetricLength:   leaderboard.MetricLength,
+	MetricDistance: leaderboard.MetricDistance,
+}
+
+func (m Metric) String() string {
+	return metricNames[m]
+}
+
+// label returns the name of m shown in the UI.
+func (m Metric) label() string {
+	return tr("metric." + m.String())
+}
+
+func (m Metric) MarshalText() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+func (m *Metric) UnmarshalText(text []byte) error {
+	for i, name := range metricNames {
+		if name == string(text) {
+			*m = Metric(i)
+			return nil
+		}
+	}
+	return fmt.Errorf("game: unknown metric %q", text)
+}
+
+type LeaderboardEntry struct {
+	Name  string    `json:"name"`
+	Score int       `json:"score"`
+	Time  time.Time `json:"time"`
+	Seed  int64     `json:"seed"`
+	Mode  string    `json:"mode"`
+	// Date is the date of the daily challenge in ModeDaily.
+	Date string `json:"date,omitempty"`
+}
+
+// Leaderboard keeps the top entries of each metric, best first. The daily
+// challenges have the lists of each date, since they are on different
+// courses.
+type Leaderboard struct {
+	Entries map[Metric][]LeaderboardEntry            `json:"entries"`
+	Daily   map[string]map[Metric][]LeaderboardEntry `json:"dailyByDate"`
+	// SharedDaily is the list which the daily challenges of all the dates
+	// shared before. It is split by the dates on load.
+	SharedDaily map[Metric][]LeaderboardEntry `json:"daily,omitempty"`
+	// LastName is the name entered last time, which new entries get by
+	// default.
+	LastName string `json:"lastName"`
+}
+
+// maxDailyDates is the number of the latest dates whose daily lists are kept.
+const maxDailyDates = 30
+
+func NewLeaderboard() *Leaderboard {
+	return &Leaderboard{
+		Entries:  map[Metric][]LeaderboardEntry{},
+		Daily:    map[string]map[Metric][]LeaderboardEntry{},
+		LastName: "AAA",
+	}
+}
+
+// lists returns the lists of mode, and of date in ModeDaily. It is nil for a
+// date without entries.
+func (l *Leaderboard) lists(mode, date string) map[Metric][]LeaderboardEntry {
+	if mode == ModeDaily {
+		return l.Daily[date]
+	}
+	return l.Entries
+}
+
+// List returns the entries of m in mode. date is the date of the daily
+// challenge in ModeDaily, and ignored otherwise.
+func (l *Leaderboard) List(mode, date string, m Metric) []LeaderboardEntry {
+	return l.lists(mode, date)[m]
+}
+
+// Qualifies reports whether score enters the top entries of m in mode.
+func (l *Leaderboard) Qualifies(mode, date string, m Metric, score int) bool {
+	if score <= 0 {
+		return false
+	}
+	entries := l.List(mode, date, m)
+	return len(entries) < leaderboardSize || score > entries[len(entries)-1].Score
+}
+
+// IsRecord reports whether score beats every entry of m in mode.
+func (l *Leaderboard) IsRecord(mode, date string, m Metric, score int) bool {
+	entries := l.List(mode, date, m)
+	return score > 0 && (len(entries) == 0 || score > entries[0].Score)
+}
+
+// Add inserts e into the entries of m in the mode and the date of e and
+// returns its rank starting from 0, or -1 if it doesn't qualify.
+func (l *Leaderboard) Add(m Metric, e LeaderboardEntry) int {
+	if !l.Qualifies(e.Mode, e.Date, m, e.Score) {
+		return -1
+	}
+	entries := l.List(e.Mode, e.Date, m)
+	// Older entries win ties.
+	rank := sort.Search(len(entries), func(i int) bool {
+		return entries[i].Score < e.Score
+	})
+	entries = append(entries, LeaderboardEntry{})
+	copy(entries[rank+1:], entries[rank:])
+	entries[rank] = e
+	if len(entries) > leaderboardSize {
+		entries = entries[:leaderboardSize]
+	}
+	lists := l.lists(e.Mode, e.Date)
+	if lists == nil {
+		lists = map[Metric][]LeaderboardEntry{}
+		l.Daily[e.Date] = lists
+		l.trimDaily()
+	}
+	lists[m] = entries
+	return rank
+}
+
+// trimDaily drops the lists of the oldest dates beyond maxDailyDates.
+func (l *Leaderboard) trimDaily() {
+	if len(l.Daily) <= maxDailyDates {
+		return
+	}
+	dates := make([]string, 0, len(l.Daily))
+	for d := range l.Daily {
+		dates = append(dates, d)
+	}
+	// The dates in DateLayout sort in time order.
+	sort.Strings(dates)
+	for _, d := range dates[:len(dates)-maxDailyDates] {
+		delete(l.Daily, d)
+	}
+}
+
+// pendingEntry is a score waiting for the player's name.
+type pendingEntry struct {
+	metric Metric
+	entry  LeaderboardEntry
+}
+
+func (g *Game) loadLeaderboard() {
+	l := NewLeaderboard()
+	loadJSON(g.storage, storageKeyLeaderboard, l)
+	if l.Entries == nil {
+		l.Entries = map[Metric][]LeaderboardEntry{}
+	}
+	if l.Daily == nil {
+		l.Daily = map[string]map[Metric][]LeaderboardEntry{}
+	}
+	for m, entries := range l.SharedDaily {
+		for _, e := range entries {
+			l.Add(m, e)
+		}
+	}
+	l.SharedDaily = nil
+	g.leaderboard = l
+}
+
+func (g *Game) saveLeaderboard() {
+	saveJSON(g.storage, storageKeyLeaderboard, g.leaderboard)
+}
+
+// collectScores keeps the scores of a landing or the end of the run which
+// qualify for the leaderboard, until the run ends. Practice runs are not
+// scored.
+func (g *Game) collectScores(scores map[Metric]int) {
+	if g.run.practice {
+		return
+	}
+	now := time.Now()
+	for m := Metric(0); m < metricNum; m++ {
+		score, ok := scores[m]
+		if !ok || !g.leaderboard.Qualifies(g.run.mode, g.run.date, m, score) {
+			continue
+		}
+		g.addPending(pendingEntry{
+			metric: m,
+			entry: LeaderboardEntry{
+				Name:  g.leaderboard.LastName,
+				Score: score,
+				Time:  now,
+				Seed:  g.ground.Seed(),
+				Mode:  g.run.mode,
+				Date:  g.run.date,
+			},
+		})
+	}
+}
+
+// addPending adds p to the scores of the run unless the run has as many
+// better ones of its metric as the leaderboard holds, and drops the worst one
+// pushed out by p.
+func (g *Game) addPending(p pendingEntry) {
+	better := 0
+	worst := -1
+	n := 0
+	for i, q := range g.pending {
+		if q.metric != p.metric {
+			continue
+		}
+		n++
+		if q.entry.Score >= p.entry.Score {
+			better++
+		}
+		if worst < 0 || q.entry.Score < g.pending[worst].entry.Score {
+			worst = i
+		}
+	}
+	if better >= leaderboardSize {
+		return
+	}
+	if n >= leaderboardSize {
+		g.pending = append(g.pending[:worst], g.pending[worst+1:]...)
+	}
+	g.pending = append(g.pending, p)
+}
+
+// submitScores adds the scores of the run to the leaderboard when it ends.
+// If any of them is a new record, the player is asked for initials once for
+// all of them. Otherwise they get the last name. The replay of the run is
+// kept only until then, since a new run records another one.
+func (g *Game) submitScores() {
+	pending := g.pending
+	replay := g.replay
+	g.pending = nil
+	if len(pending) == 0 {
+		return
+	}
+	isRecord := false
+	for _, p := range pending {
+		if g.leaderboard.IsRecord(p.entry.Mode, p.entry.Date, p.metric, p.entry.Score) {
+			isRecord = true
+		}
+	}
+	if !isRecord {
+		g.addEntries(pending, replay)
+		return
+	}
+	g.openMenu(g.newInitialsMenu(pending, replay))
+}
+
+func (g *Game) addEntries(pending []pendingEntry, replay *physics.Replay) {
+	for _, p := range pending {
+		g.leaderboard.Add(p.metric, p.entry)
+	}
+	g.saveLeaderboard()
+	g.submitOnline(pending, replay)
+}
+
+// SetLeaderboardURL makes the game submit scores to the online leaderboard at
+// url, and show its top lists.
+func (g *Game) SetLeaderboardURL(url string) {
+	g.online = leaderboard.NewClient(url, nil, g.storage)
+}
+
+// submitOnline sends the best entry of each metric with the replay of the
+// run as a batch from a goroutine, so that they reach the server in order.
+// The others would rarely make the online top lists, and each submission
+// carries the whole replay, which also stays in the queue while offline.
+func (g *Game) submitOnline(pending []pendingEntry, replay *physics.Replay) {
+	if g.online == nil {
+		return
+	}
+	best := map[Metric]pendingEntry{}
+	for _, p := range pending {
+		if b, ok := best[p.metric]; !ok || p.entry.Score > b.entry.Score {
+			best[p.metric] = p
+		}
+	}
+	var scores []leaderboard.Score
+	for m := Metric(0); m < metricNum; m++ {
+		p, ok := best[m]
+		if !ok {
+			continue
+		}
+		scores = append(scores, leaderboard.Score{
+			Name:   p.entry.Name,
+			Metric: p.metric.String(),
+			Score:  p.entry.Score,
+			Seed:   p.entry.Seed,
+			Mode:   p.entry.Mode,
+			Date:   p.entry.Date,
+			Time:   p.entry.Time,
+			Replay: replay,
+		})
+	}
+	go func() {
+		if err := g.online.Submit(scores...); err != nil {
+			log.Println(err)
+		}
+	}()
+}
+
+func (g *Game) flushOnline() {
+	if g.online == nil {
+		return
+	}
+	go func() {
+		if err := g.online.Flush(); err != nil {
+			log.Println(err)
+		}
+	}()
+}
+
+func (g *Game) newInitialsMenu(pending []pendingEntry, replay *physics.Replay) *Menu {
+	// A run may have several records of a metric, and the best one is shown.
+	records := map[Metric]int{}
+	for _, p := range pending {
+		if g.leaderboard.IsRecord(p.entry.Mode, p.entry.Date, p.metric, p.entry.Score) && p.entry.Score > records[p.metric] {
+			records[p.metric] = p.entry.Score
+		}
+	}
+	var titles []string
+	for m := Metric(0); m < metricNum; m++ {
+		if score, ok := records[m]; ok {
+			titles = append(titles, fmt.Sprintf("%s %d", m.label(), score))
+		}
+	}
+	initials := newInitialsInput(g.leaderboard.LastName)
+	ok := NewButton(tr("menu.ok"), g.closeMenu)
+
+	content := NewVBox(menuSpacing, NewLabel(tr("leaderboard.newRecord")))
+	for _, t := range titles {
+		content.Add(NewLabel(t))
+	}
+	content.Add(initials, ok)
+	focusables := append(initials.Focusables(), ok)
+	m := NewMenuWithContent(content, focusables...)
+	m.typing = true
+	// The scores are kept even if the menu is closed without OK.
+	m.onClose = func() {
+		name := initials.Name()
+		g.leaderboard.LastName = name
+		for i := range pending {
+			pending[i].entry.Name = name
+		}
+		g.addEntries(pending, replay)
+	}
+	return m
+}
+
+func leaderboardRow(rank int, name string, score int, date string) Element {
+	return NewLabel(fmt.Sprintf("%2d %-3s %6d %s", rank+1, name, score, date))
+}
+
+// entryDate returns the date shown in the leaderboard, which is the date of
+// the course for the daily challenges.
+func entryDate(mode, date string, t time.Time) string {
+	if mode == ModeDaily {
+		return date
+	}
+	return t.Format(leaderboard.DateLayout)
+}
+
+func (g *Game) newLeaderboardMenu() *Menu {
+	table := NewVBox(menuSpacing / 2)
+	metric := MetricHeight
+	mode := ModeNormal
+	online := false
+	// fetchID discards the responses for the tables not shown any more.
+	fetchID := 0
+	var showTable func()
+	showTable = func() {
+		// Only today's daily challenge is shown.
+		date := leaderboard.DailyDate(time.Now())
+		table.Clear()
+		fetchID++
+		if online {
+			table.Add(NewLabel(tr("leaderboard.loading")))
+			id := fetchID
+			m := metric
+			md := mode
+			go func() {
+				scores, err := g.online.Top(m.String(), md, date, leaderboardSize)
+				g.runOnGameLoop(func() {
+					if id != fetchID {
+						return
+					}
+					table.Clear()
+					if err != nil {
+						log.Println(err)
+						table.Add(NewLabel(tr("leaderboard.offline")))
+						return
+					}
+					if len(scores) == 0 {
+						table.Add(NewLabel(tr("leaderboard.noEntries")))
+					}
+					for i, s := range scores {
+						table.Add(leaderboardRow(i, s.Name, s.Score, entryDate(s.Mode, s.Date, s.Time)))
+					}
+				})
+			}()
+			return
+		}
+		entries := g.leaderboard.List(mode, date, metric)
+		if len(entries) == 0 {
+			table.Add(NewLabel(tr("leaderboard.noEntries")))
+			return
+		}
+		for i, e := range entries {
+			table.Add(leaderboardRow(i, e.Name, e.Score, entryDate(e.Mode, e.Date, e.Time)))
+		}
+	}
+	showTable()
+
+	tabs := NewHBox(menuSpacing)
+	focusables := []Element{}
+	for m := Metric(0); m < metricNum; m++ {
+		m := m
+		tab := NewButton(m.label(), func() {
+			metric = m
+			showTable()
+		})
+		tabs.Add(tab)
+		focusables = append(focusables, tab)
+	}
+	buttons := NewHBox(menuSpacing)
+	dailyToggle := NewToggle(tr("leaderboard.daily"), false, func(value bool) {
+		mode = ModeNormal
+		if value {
+			mode = ModeDaily
+		}
+		showTable()
+	})
+	buttons.Add(dailyToggle)
+	focusables = append(focusables, dailyToggle)
+	if g.online != nil {
+		onlineToggle := NewToggle(tr("leaderboard.online"), false, func(value bool) {
+			online = value
+			showTable()
+		})
+		buttons.Add(onlineToggle)
+		focusables = append(focusables, onlineToggle)
+	}
+	back := NewButton(tr("menu.back"), g.closeMenu)
+	buttons.Add(back)
+	focusables = append(focusables, back)
+
+	content := NewVBox(menuSpacing, NewLabel(tr("menu.leaderboard")), tabs, table, buttons)
+	return NewMenuWithContent(content, focusables...)
+}
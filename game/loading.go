@@ -0,0 +1,99 @@
+package game
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hajimehoshi/ebiten"
+	"github.com/hajimehoshi/ebiten/ebitenutil"
+)
+
+// loadBudget is the time spent on loading in a tick. Loading takes over more
+// ticks on slow platforms such as browsers, which show the progress meanwhile.
+const loadBudget = 10 * time.Millisecond
+
+// loadingBarWidth and loadingBarHeight are in logical pixels.
+const (
+	loadingBarWidth  = 320
+	loadingBarHeight = 8
+)
+
+// loadStep is a step of loading the built-in assets. A step which fails
+// leaves placeholders for its assets, so that the game still runs.
+type loadStep struct {
+	name string
+	load func() error
+}
+
+// assetLoader runs the steps of loading over the ticks and keeps their errors
+// to report them.
+type assetLoader struct {
+	steps []loadStep
+	next  int
+	errs  []error
+}
+
+// builtinAssets loads the built-in assets once for any game. The sounds come
+// last since they depend on the audio context.
+var builtinAssets = &assetLoader{
+	steps: []loadStep{
+		{name: "atlas", load: loadBuiltinAtlas},
+		{name: "fonts", load: loadFonts},
+		{name: "ground", load: initGroundImages},
+		{name: "achievements", load: loadAchievementDefs},
+		{name: "sfx", load: loadSFX},
+		{name: "audio", load: initAudio},
+	},
+}
+
+// Update runs the steps until they finish or take longer than budget.
+func (l *assetLoader) Update(budget time.Duration) {
+	start := time.Now()
+	for !l.Done() {
+		s := l.steps[l.next]
+		l.next++
+		if err := s.load(); err != nil {
+			l.errs = append(l.errs, fmt.Errorf("game: failed to load %s: %v", s.name, err))
+		}
+		if time.Since(start) >= budget {
+			return
+		}
+	}
+}
+
+func (l *assetLoader) Done() bool {
+	return l.next >= len(l.steps)
+}
+
+// Progress returns the ratio of the finished steps.
+func (l *assetLoader) Progress() float64 {
+	return float64(l.next) / float64(len(l.steps))
+}
+
+// Errors returns the errors of the failed steps.
+func (l *assetLoader) Errors() []error {
+	return l.errs
+}
+
+// reportLoadErrors logs the errors of loading and tells the player that some
+// assets are missing.
+func (g *Game) reportLoadErrors() {
+	errs := builtinAssets.Errors()
+	for _, err := range errs {
+		log.Println(err)
+	}
+	if len(errs) > 0 {
+		g.toast.Show(tr("loading.failed"))
+	}
+}
+
+// drawLoading draws the loading scene, which is a bar of the progress.
+func (g *Game) drawLoading(screen *ebiten.Image) {
+	screen.Fill(backgroundColor)
+	w, h := dp(loadingBarWidth), dp(loadingBarHeight)
+	x := float64(screenWidth-w) / 2
+	y := float64(screenHeight-h) / 2
+	ebitenutil.DrawRect(screen, x, y, float64(w), float64(h), widgetColor)
+	ebitenutil.DrawRect(screen, x, y, float64(w)*builtinAssets.Progress(), float64(h), groundSurfaceColor)
+}
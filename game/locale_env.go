@@ -0,0 +1,17 @@
+//go:build !js
+// +build !js
+
+package game
+
+import "os"
+
+// detectLocale returns the locale in the environment variables, which are
+// set on desktop.
+func detectLocale() string {
+	for _, key := range []string{"LC_ALL", "LC_MESSAGES", "LANG"} {
+		if v := os.Getenv(key); v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,15 @@
+//go:build js
+// +build js
+
+package game
+
+import "syscall/js"
+
+// detectLocale returns the language of the browser.
+func detectLocale() string {
+	v := js.Global().Get("navigator").Get("language")
+	if v.Type() != js.TypeString {
+		return ""
+	}
+	return v.String()
+}
@@ -0,0 +1,122 @@
+package game
+
+const menuSpacing = 8
+
+// Menu is a panel shown at the center of the screen. Gameplay is paused while
+// any menu is open.
+type Menu struct {
+	*Panel
+	focus *FocusGroup
+
+	// typing makes key presses go only to the menu, not to the actions.
+	typing  bool
+	onClose func()
+	// rebuild makes the menu again, e.g. in another language. It is nil for
+	// the menus which are kept as they are.
+	rebuild func() *Menu
+}
+
+// NewMenu creates a menu showing title and items in a column. The items are
+// focusable in this order.
+func NewMenu(title string, items ...Element) *Menu {
+	content := NewVBox(menuSpacing, NewLabel(title))
+	content.Add(items...)
+	return &Menu{
+		Panel: NewPanel(content),
+		focus: NewFocusGroup(items...),
+	}
+}
+
+// NewMenuWithContent creates a menu from content whose focusable elements are
+// given separately, e.g. when they are nested in rows.
+func NewMenuWithContent(content Element, focusables ...Element) *Menu {
+	return &Menu{
+		Panel: NewPanel(content),
+		focus: NewFocusGroup(focusables...),
+	}
+}
+
+func (g *Game) openMenu(m *Menu) {
+	g.menus = append(g.menus, m)
+	g.ui.Add(m, AnchorCenter, 0, 0)
+}
+
+// openNewMenu opens the menu made by build, which makes it again when the
+// menus are rebuilt.
+func (g *Game) openNewMenu(build func() *Menu) {
+	m := build()
+	m.rebuild = build
+	g.openMenu(m)
+}
+
+// rebuildMenus makes the open menus again in their order, e.g. after the
+// language changes.
+func (g *Game) rebuildMenus() {
+	for _, m := range g.menus {
+		g.ui.Remove(m)
+	}
+	for i, m := range g.menus {
+		if m.rebuild != nil {
+			n := m.rebuild()
+			n.rebuild = m.rebuild
+			g.menus[i] = n
+		}
+		g.ui.Add(g.menus[i], AnchorCenter, 0, 0)
+	}
+	g.rebind = nil
+}
+
+func (g *Game) closeMenu() {
+	if len(g.menus) == 0 {
+		return
+	}
+	m := g.menus[len(g.menus)-1]
+	g.menus = g.menus[:len(g.menus)-1]
+	g.ui.Remove(m)
+	g.rebind = nil
+	if m.onClose != nil {
+		m.onClose()
+	}
+}
+
+func (g *Game) closeAllMenus() {
+	for len(g.menus) > 0 {
+		g.closeMenu()
+	}
+}
+
+func (g *Game) isPaused() bool {
+	return len(g.menus) > 0
+}
+
+func (g *Game) isTyping() bool {
+	return len(g.menus) > 0 && g.menus[len(g.menus)-1].typing
+}
+
+// currentFocus returns the focus group of the top menu, or of the HUD when
+// no menu is open.
+func (g *Game) currentFocus() *FocusGroup {
+	if len(g.menus) == 0 {
+		return g.focus
+	}
+	return g.menus[len(g.menus)-1].focus
+}
+
+func (g *Game) newPauseMenu() *Menu {
+	return NewMenu(tr("menu.paused"),
+		NewButton(tr("menu.resume"), g.closeMenu),
+		NewButton(tr("menu.settings"), func() {
+			g.openNewMenu(g.newSettingsMenu)
+		}),
+		NewButton(tr("menu.daily"), func() {
+			g.openNewMenu(g.newDailyMenu)
+		}),
+		NewButton(tr("menu.leaderboard"), func() {
+			g.openNewMenu(g.newLeaderboardMenu)
+		}),
+		NewButton(tr("menu.stats"), func() {
+			g.openNewMenu(g.newStatsMenu)
+		}),
+		NewButton(tr("menu.restart"), g.restart),
+	)
+}
@@ -0,0 +1,225 @@
+package game
+
+import (
+	"encoding/json"
+	"fmt"
+	"image"
+	"image/color"
+	"io"
+	"io/ioutil"
+	"log"
+	"path"
+	"strconv"
+
+	"github.com/hajimehoshi/ebiten"
+)
+
+// packManifestName is the name of the manifest at the root of an asset pack.
+const packManifestName = "manifest.json"
+
+// packManifest lists the files of an asset pack by the names of the built-in
+// assets they override. Colors are given as "#rrggbb" or "#rrggbbaa", and
+// sounds as WAV files.
+type packManifest struct {
+	Name    string            `json:"name"`
+	Sprites map[string]string `json:"sprites"`
+	Colors  map[string]string `json:"colors"`
+	Sounds  map[string]string `json:"sounds"`
+}
+
+// assetSource opens the files of an asset pack by their slash-separated
+// paths, e.g. in a directory or in a zip file.
+type assetSource interface {
+	Open(name string) (io.ReadCloser, error)
+	Close() error
+}
+
+// AssetPack is a skin or a mod which overrides some of the built-in assets.
+// The assets it doesn't have stay the built-in ones.
+type AssetPack struct {
+	Name string
+
+	sprites map[string]*ebiten.Image
+	colors  map[string]color.NRGBA
+	sounds  map[string][]byte
+}
+
+func loadAssetPack(src assetSource) (*AssetPack, error) {
+	var m packManifest
+	if err := readJSON(src, packManifestName, &m); err != nil {
+		return nil, err
+	}
+	p := &AssetPack{
+		Name:    m.Name,
+		sprites: map[string]*ebiten.Image{},
+		colors:  map[string]color.NRGBA{},
+		sounds:  map[string][]byte{},
+	}
+	for name, file := range m.Sprites {
+		if _, ok := sprites[name]; !ok {
+			log.Printf("game: unknown sprite %q in asset pack %q", name, m.Name)
+			continue
+		}
+		img, err := readImage(src, file)
+		if err != nil {
+			return nil, err
+		}
+		p.sprites[name] = img
+	}
+	for name, value := range m.Colors {
+		if _, ok := paletteColors[name]; !ok {
+			log.Printf("game: unknown color %q in asset pack %q", name, m.Name)
+			continue
+		}
+		c, err := parseColor(value)
+		if err != nil {
+			return nil, err
+		}
+		p.colors[name] = c
+	}
+	for name, file := range m.Sounds {
+		if !isPackSound(name) {
+			log.Printf("game: unknown sound %q in asset pack %q", name, m.Name)
+			continue
+		}
+		if path.Ext(file) != ".wav" {
+			return nil, fmt.Errorf("game: sound %q is not a WAV file", file)
+		}
+		data, err := readFile(src, file)
+		if err != nil {
+			return nil, err
+		}
+		p.sounds[name] = data
+	}
+	return p, nil
+}
+
+func readFile(src assetSource, name string) ([]byte, error) {
+	f, err := src.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ioutil.ReadAll(f)
+}
+
+func readJSON(src assetSource, name string, v interface{}) error {
+	data, err := readFile(src, name)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+func readImage(src assetSource, name string) (*ebiten.Image, error) {
+	f, err := src.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	img, _, err := image.Decode(f)
+	if err != nil {
+		return nil, fmt.Errorf("game: failed to decode %q: %v", name, err)
+	}
+	return ebiten.NewImageFromImage(img, ebiten.FilterDefault)
+}
+
+func parseColor(s string) (color.NRGBA, error) {
+	if len(s) != 7 && len(s) != 9 || s[0] != '#' {
+		return color.NRGBA{}, fmt.Errorf("game: invalid color %q", s)
+	}
+	v, err := strconv.ParseUint(s[1:], 16, 32)
+	if err != nil {
+		return color.NRGBA{}, fmt.Errorf("game: invalid color %q", s)
+	}
+	if len(s) == 7 {
+		v = v<<8 | 0xff
+	}
+	return color.NRGBA{R: uint8(v >> 24), G: uint8(v >> 16), B: uint8(v >> 8), A: uint8(v)}, nil
+}
+
+// apply replaces the current assets with the built-in ones overridden by p.
+// p may be nil to go back to the built-in assets.
+func (p *AssetPack) apply() {
+	for name, img := range builtinSprites {
+		*sprites[name] = img
+	}
+	for name, c := range builtinColors {
+		*paletteColors[name] = c
+	}
+	packSounds = nil
+	if p != nil {
+		for name, img := range p.sprites {
+			*sprites[name] = img
+		}
+		for name, c := range p.colors {
+			*paletteColors[name] = c
+		}
+		packSounds = p.sounds
+	}
+	if err := initGroundImages(); err != nil {
+		log.Printf("game: failed to draw the ground: %v", err)
+	}
+}
+
+// UseAssetPack loads the asset pack in the directory or the zip file at path
+// and applies it, e.g. for a command line flag. It is kept over the pack
+// chosen in the settings. The pack is applied on the game loop after the
+// built-in assets are loaded.
+func (g *Game) UseAssetPack(path string) error {
+	p, err := openAssetPack(path)
+	if err != nil {
+		return err
+	}
+	g.packFixed = true
+	g.runOnGameLoop(func() {
+		g.setAssetPack(p)
+	})
+	return nil
+}
+
+func (g *Game) setAssetPack(p *AssetPack) {
+	p.apply()
+	g.reloadSounds()
+}
+
+// loadSavedAssetPack applies the pack chosen in the settings, which is one of
+// the installed packs.
+func (g *Game) loadSavedAssetPack() {
+	if g.packFixed || g.pack == "" {
+		return
+	}
+	p, err := openInstalledAssetPack(g.pack)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	g.setAssetPack(p)
+}
+
+// nextAssetPack switches to the next installed pack, or to the built-in
+// assets after the last one, and reopens the settings menu.
+func (g *Game) nextAssetPack() {
+	names := append([]string{""}, listAssetPacks()...)
+	next := names[0]
+	for i, name := range names {
+		if name == g.pack && i+1 < len(names) {
+			next = names[i+1]
+		}
+	}
+	var p *AssetPack
+	if next != "" {
+		var err error
+		p, err = openInstalledAssetPack(next)
+		if err != nil {
+			log.Println(err)
+			return
+		}
+	}
+	g.pack = next
+	g.packFixed = false
+	g.setAssetPack(p)
+	g.saveSettings()
+	g.closeMenu()
+	g.openNewMenu(g.newSettingsMenu)
+}
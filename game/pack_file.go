@@ -0,0 +1,122 @@
+//go:build !js
+// +build !js
+
+package game
+
+import (
+	"archive/zip"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// packsDirName is the directory under the config directory where the asset
+// packs are installed.
+const packsDirName = "packs"
+
+var errInvalidPackPath = errors.New("game: invalid path in asset pack")
+
+// dirSource is an asset pack in a directory.
+type dirSource string
+
+func (d dirSource) Open(name string) (io.ReadCloser, error) {
+	name, err := cleanPackPath(name)
+	if err != nil {
+		return nil, err
+	}
+	return os.Open(filepath.Join(string(d), filepath.FromSlash(name)))
+}
+
+func (d dirSource) Close() error {
+	return nil
+}
+
+// zipSource is an asset pack in a zip file.
+type zipSource struct {
+	*zip.ReadCloser
+}
+
+func (z zipSource) Open(name string) (io.ReadCloser, error) {
+	name, err := cleanPackPath(name)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range z.File {
+		if f.Name == name {
+			return f.Open()
+		}
+	}
+	return nil, fmt.Errorf("game: %q is not in the asset pack", name)
+}
+
+// cleanPackPath rejects the paths going out of the pack.
+func cleanPackPath(name string) (string, error) {
+	name = path.Clean(name)
+	if path.IsAbs(name) || name == ".." || strings.HasPrefix(name, "../") {
+		return "", errInvalidPackPath
+	}
+	return name, nil
+}
+
+// openAssetPack loads the asset pack in the directory or the zip file at p.
+func openAssetPack(p string) (*AssetPack, error) {
+	fi, err := os.Stat(p)
+	if err != nil {
+		return nil, err
+	}
+	var src assetSource
+	if fi.IsDir() {
+		src = dirSource(p)
+	} else {
+		z, err := zip.OpenReader(p)
+		if err != nil {
+			return nil, err
+		}
+		src = zipSource{z}
+	}
+	defer src.Close()
+	return loadAssetPack(src)
+}
+
+func packsDir() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, configDirName, packsDirName), nil
+}
+
+// listAssetPacks returns the names of the installed packs, which are the
+// directories and the zip files in the packs directory.
+func listAssetPacks() []string {
+	dir, err := packsDir()
+	if err != nil {
+		return nil
+	}
+	fis, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil
+	}
+	var names []string
+	for _, fi := range fis {
+		if fi.IsDir() || filepath.Ext(fi.Name()) == ".zip" {
+			names = append(names, fi.Name())
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func openInstalledAssetPack(name string) (*AssetPack, error) {
+	dir, err := packsDir()
+	if err != nil {
+		return nil, err
+	}
+	return openAssetPack(filepath.Join(dir, name))
+}
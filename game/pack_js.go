@@ -0,0 +1,21 @@
+//go:build js
+// +build js
+
+package game
+
+import "errors"
+
+var errPacksNotSupported = errors.New("game: asset packs are not supported in browsers")
+
+func openAssetPack(p string) (*AssetPack, error) {
+	return nil, errPacksNotSupported
+}
+
+// listAssetPacks returns nothing since browsers can't read local files.
+func listAssetPacks() []string {
+	return nil
+}
+
+func openInstalledAssetPack(name string) (*AssetPack, error) {
+	return nil, errPacksNotSupported
+}
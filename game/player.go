@@ -4,175 +4,152 @@ import (
 	"math"
 
 	"github.com/hajimehoshi/ebiten"
-	"github.com/hajimehoshi/ebiten/inpututil"
-)
-
-const (
-	minV     = 2
-	gravity  = 0.05
-	friction = 0.02
+	"github.com/hiroebe/osushi/physics"
 )
 
 type Player struct {
-	jumpSound *JumpSound
-
-	x, y      float64
-	vx, vy    float64
-	isJumping bool
+	physics.Body
 
-	jumpHeight float64
-	jumpLength float64
-	jumpStartX float64
+	jumpSound *JumpSound
 
-	img       *ebiten.Image
-	imgFrames int
+	anim *Animator
 }
 
-func (p *Player) Update(gy, grad float64) {
-	obl := math.Sqrt(1 + grad*grad)
-
-	p.updateV(grad, obl)
+type playerState int
 
-	p.x += p.vx
-	p.y += p.vy
+const (
+	playerRolling playerState = iota
+	playerDiving
+	playerFlying
+	playerLanding
+	playerWipeout
+)
 
-	if !p.isJumping || p.y < gy {
-		p.y = gy
-		if p.isJumping {
-			p.land(grad, obl)
-		}
-	}
+// Events of the player's clips, which the sound effects are played on.
+const (
+	playerEventLand    = "land"
+	playerEventWipeout = "wipeout"
+)
 
-	p.updateJumpScore()
-	p.updateImg()
+// playerClips are the clips played in each state of the player. Landing and
+// wipeout play once, and the player goes back to the other states after them.
+var playerClips = map[playerState]*AnimationClip{
+	playerRolling: {
+		Name:   "rolling",
+		Frames: []AnimationFrame{{Sprite: "gopher-normal", Ticks: 1}},
+	},
+	playerDiving: {
+		Name:   "diving",
+		Frames: []AnimationFrame{{Sprite: "gopher-accelerate", Ticks: 1}},
+	},
+	playerFlying: {
+		Name: "flying",
+		Frames: []AnimationFrame{
+			{Sprite: "gopher-fly-1", Ticks: 10},
+			{Sprite: "gopher-fly-2", Ticks: 10},
+		},
+	},
+	playerLanding: {
+		Name: "landing",
+		Frames: []AnimationFrame{
+			{Sprite: "gopher-accelerate", Ticks: 6, Event: playerEventLand},
+			{Sprite: "gopher-normal", Ticks: 1},
+		},
+		Mode: AnimationOnce,
+	},
+	playerWipeout: {
+		Name: "wipeout",
+		Frames: []AnimationFrame{
+			{Sprite: "gopher-fly-2", Ticks: 5, Event: playerEventWipeout},
+			{Sprite: "gopher-normal", Ticks: 5},
+			{Sprite: "gopher-fly-2", Ticks: 5},
+			{Sprite: "gopher-normal", Ticks: 5},
+			{Sprite: "gopher-fly-2", Ticks: 5},
+			{Sprite: "gopher-normal", Ticks: 1},
+		},
+		Mode: AnimationOnce,
+	},
 }
 
-func (p *Player) updateV(grad, obl float64) {
-	g := -gravity
-	if isKeyPressed() {
-		g *= 3
-	}
-	if p.isJumping {
-		p.vy += g
-		return
-	}
-
-	v := math.Sqrt(p.vx*p.vx+p.vy*p.vy) + g*grad/obl - friction/obl
-	if v < minV {
-		v = minV
+// NewPlayer creates a player. onEvent is called for the events of its
+// animation.
+func NewPlayer(jumpSound *JumpSound, onEvent func(clip, event string)) *Player {
+	clips := make([]*AnimationClip, 0, len(playerClips))
+	for _, c := range playerClips {
+		clips = append(clips, c)
 	}
-	p.vx = v / obl
-	p.vy = v * grad / obl
-
-	if isKeyJustReleased() {
-		p.jump(grad, obl)
-		return
+	p := &Player{
+		jumpSound: jumpSound,
+		anim:      NewAnimator(clips...),
 	}
+	p.anim.OnEvent = onEvent
+	p.anim.Play(playerClips[playerRolling].Name)
+	return p
 }
 
-func (p *Player) jump(grad, obl float64) {
-	p.jumpSound.Start()
-
-	p.isJumping = true
-	p.jumpStartX = p.x
-
-	p.vy += gravity / obl
-}
-
-func (p *Player) land(grad, obl float64) {
-	p.jumpSound.Stop()
-
-	p.isJumping = false
-
-	dv := (p.vx + p.vy*grad) / obl
-	if dv < 0 {
-		p.vx = 0
-		p.vy = 0
-		return
-	}
-	if p.jumpLength > minMountainWidth {
-		dv *= 1.1
+// playerInput reads the actions for the player in the current tick.
+func playerInput() physics.Input {
+	return physics.Input{
+		Dive:   actions.Depth(ActionDive),
+		Jump:   actions.IsJustReleased(ActionJump),
+		Rotate: actions.IsPressed(ActionRotate),
 	}
-	p.vx = dv / obl
-	p.vy = dv * grad / obl
 }
 
-func (p *Player) updateJumpScore() {
-	if !p.isJumping {
-		p.jumpHeight = 0
-		p.jumpLength = 0
-		return
+func (p *Player) Update(in physics.Input, gy, grad float64) {
+	p.Step(in, gy, grad)
+	if p.Jumped {
+		p.jumpSound.Start()
 	}
-	p.jumpLength = p.x - p.jumpStartX
-	if p.y > p.jumpHeight {
-		p.jumpHeight = p.y
+	if p.Landed {
+		p.jumpSound.Stop()
 	}
+	p.updateAnimation(in)
 }
 
-func (p *Player) updateImg() {
-	if isKeyPressed() {
-		p.img = gopherImageAcceralate
-		return
-	}
-	if !p.isJumping {
-		p.img = gopherImageNormal
-		return
-	}
-	p.imgFrames++
-	if p.imgFrames < 10 {
-		return
-	}
-	p.imgFrames = 0
-	if p.img == gopherImageFly1 {
-		p.img = gopherImageFly2
-	} else {
-		p.img = gopherImageFly1
-	}
+func (p *Player) state(in physics.Input) playerState {
+	switch {
+	case p.Landed && p.LastJump.Alignment < 0:
+		// The player hit the slope head-on and stopped.
+		return playerWipeout
+	case p.Landed:
+		return playerLanding
+	case in.Dive > 0:
+		return playerDiving
+	case p.IsJumping:
+		return playerFlying
+	}
+	return playerRolling
+}
 
+func (p *Player) updateAnimation(in physics.Input) {
+	state := p.state(in)
+	name := playerClips[state].Name
+	switch {
+	case state == playerLanding || state == playerWipeout:
+		p.anim.Restart(name)
+	case p.anim.Busy() && !p.Jumped:
+		// Let landing and wipeout finish.
+	default:
+		p.anim.Play(name)
+	}
+	p.anim.Update()
 }
 
 func (p *Player) Draw(screen *ebiten.Image, scale float64) {
-	w, h := p.img.Size()
+	img := p.anim.Image()
+	w, h := img.Size()
 	x := playerOffset * scale
-	y := float64(screenHeight) - p.y*scale + float64(h)/10
-	grad := -p.vy / p.vx
+	y := float64(screenHeight) - p.Y*scale + float64(h)/10*scale
+	grad := -p.VY / p.VX
 
 	opts := &ebiten.DrawImageOptions{}
 	opts.Filter = ebiten.FilterLinear
 	opts.GeoM.Translate(-float64(w)/2, -float64(h))
-	opts.GeoM.Rotate(math.Atan(grad))
+	// Rotation is counterclockwise while the screen's y axis points down.
+	opts.GeoM.Rotate(math.Atan(grad) - p.Rotation)
 	opts.GeoM.Scale(scale, scale)
 	opts.GeoM.Translate(x, y)
 
-	screen.DrawImage(p.img, opts)
-}
-
-var touching bool
-
-func isKeyPressed() bool {
-	if ebiten.IsKeyPressed(ebiten.KeySpace) {
-		return true
-	}
-	if ebiten.IsMouseButtonPressed(ebiten.MouseButtonLeft) {
-		return true
-	}
-	if len(ebiten.TouchIDs()) > 0 {
-		touching = true
-		return true
-	}
-	return false
-}
-
-func isKeyJustReleased() bool {
-	if inpututil.IsKeyJustReleased(ebiten.KeySpace) {
-		return true
-	}
-	if inpututil.IsMouseButtonJustReleased(ebiten.MouseButtonLeft) {
-		return true
-	}
-	if touching && len(ebiten.TouchIDs()) == 0 {
-		touching = false
-		return true
-	}
-	return false
+	screen.DrawImage(img, opts)
 }
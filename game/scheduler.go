@@ -0,0 +1,188 @@
+package game
+
+import (
+	"github.com/hajimehoshi/ebiten"
+	"github.com/hajimehoshi/ebiten/audio"
+)
+
+type soundAction int
+
+const (
+	soundPlay soundAction = iota
+	soundPause
+	soundRelease
+)
+
+type soundEvent struct {
+	tick   int
+	player soundPlayer
+	action soundAction
+	// synth is the note to release by soundRelease.
+	synth *Synth
+}
+
+// SoundScheduler owns the players of the sounds and plays and pauses them on
+// the ticks of the game rather than on timers, so that the sounds keep in
+// time with the game and stop while it is paused or suspended. It is used
+// only on the game loop, e.g. from Game.Update.
+type SoundScheduler struct {
+	tick    int
+	events  []soundEvent
+	players []soundPlayer
+
+	suspended bool
+	// resumed are the players paused by Suspend to play again on Resume.
+	resumed []soundPlayer
+}
+
+func NewSoundScheduler() *SoundScheduler {
+	return &SoundScheduler{}
+}
+
+// msToTicks returns the number of ticks in ms milliseconds.
+func msToTicks(ms int) int {
+	return ms * ebiten.MaxTPS() / 1000
+}
+
+// NewPlayer makes a player of src which the scheduler owns until Close.
+func (s *SoundScheduler) NewPlayer(src audio.ReadSeekCloser) soundPlayer {
+	p := newSoundPlayer(src)
+	s.players = append(s.players, p)
+	return p
+}
+
+// Play plays p from the start after delay ticks, or at once if delay is 0. It
+// cancels the events of p scheduled before.
+func (s *SoundScheduler) Play(p soundPlayer, delay int) {
+	s.Cancel(p)
+	s.schedule(p, soundPlay, delay)
+}
+
+// Pause pauses p after delay ticks, or at once if delay is 0.
+func (s *SoundScheduler) Pause(p soundPlayer, delay int) {
+	s.schedule(p, soundPause, delay)
+}
+
+// Release fades out the note w of p after delay ticks by its envelope, and
+// then pauses p. If w is nil or p is not playing then, it pauses p at once.
+func (s *SoundScheduler) Release(p soundPlayer, w *Synth, delay int) {
+	s.add(soundEvent{tick: s.tick + delay, player: p, action: soundRelease, synth: w})
+}
+
+func (s *SoundScheduler) schedule(p soundPlayer, action soundAction, delay int) {
+	s.add(soundEvent{tick: s.tick + delay, player: p, action: action})
+}
+
+func (s *SoundScheduler) add(e soundEvent) {
+	if e.tick <= s.tick {
+		// A sound stopped while suspended must not play again on Resume.
+		if e.action != soundPlay {
+			s.resumed = removePlayer(s.resumed, e.player)
+		}
+		if e.action != soundPlay || !s.suspended {
+			s.run(e)
+			return
+		}
+	}
+	// Keep the events in the order of their ticks, and of the calls for the
+	// same tick.
+	i := len(s.events)
+	for i > 0 && s.events[i-1].tick > e.tick {
+		i--
+	}
+	s.events = append(s.events, soundEvent{})
+	copy(s.events[i+1:], s.events[i:])
+	s.events[i] = e
+}
+
+// Cancel removes the events of p which have not happened yet.
+func (s *SoundScheduler) Cancel(p soundPlayer) {
+	events := s.events[:0]
+	for _, e := range s.events {
+		if e.player != p {
+			events = append(events, e)
+		}
+	}
+	s.events = events
+}
+
+// Close cancels the events of p and closes it.
+func (s *SoundScheduler) Close(p soundPlayer) error {
+	s.Cancel(p)
+	s.players = removePlayer(s.players, p)
+	s.resumed = removePlayer(s.resumed, p)
+	return p.Close()
+}
+
+func removePlayer(players []soundPlayer, p soundPlayer) []soundPlayer {
+	for i, q := range players {
+		if q == p {
+			return append(players[:i], players[i+1:]...)
+		}
+	}
+	return players
+}
+
+// Update advances a tick and runs the events due. It does nothing while the
+// scheduler is suspended.
+func (s *SoundScheduler) Update() {
+	if s.suspended {
+		return
+	}
+	s.tick++
+	n := 0
+	for n < len(s.events) && s.events[n].tick <= s.tick {
+		s.run(s.events[n])
+		n++
+	}
+	s.events = s.events[n:]
+}
+
+func (s *SoundScheduler) run(e soundEvent) {
+	switch e.action {
+	case soundPlay:
+		if e.player.Current() != 0 {
+			e.player.Rewind()
+		}
+		e.player.Play()
+	case soundPause:
+		e.player.Pause()
+	case soundRelease:
+		// A note which hasn't started, e.g. of a jump shorter than the delay
+		// of its sound, must not be released, or it would play silent the
+		// next time since the synth is not reset from the start.
+		if e.synth == nil || s.suspended || !e.player.IsPlaying() {
+			e.player.Pause()
+			return
+		}
+		e.synth.Release()
+		s.schedule(e.player, soundPause, e.synth.env.ReleaseTicks())
+	}
+}
+
+// Suspend pauses the playing sounds and holds the events until Resume, e.g.
+// while the game is paused. It is safe to call on every tick.
+func (s *SoundScheduler) Suspend() {
+	if s.suspended {
+		return
+	}
+	s.suspended = true
+	for _, p := range s.players {
+		if p.IsPlaying() {
+			p.Pause()
+			s.resumed = append(s.resumed, p)
+		}
+	}
+}
+
+// Resume plays the sounds paused by Suspend again from where they were.
+func (s *SoundScheduler) Resume() {
+	if !s.suspended {
+		return
+	}
+	s.suspended = false
+	for _, p := range s.resumed {
+		p.Play()
+	}
+	s.resumed = nil
+}
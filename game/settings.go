@@ -0,0 +1,200 @@
+package game
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hajimehoshi/ebiten"
+)
+
+const maxBindingLabels = 2
+
+// loadBindings returns the saved bindings. Actions missing in the storage get
+// their default bindings.
+func loadBindings(s Storage) Bindings {
+	bindings := DefaultBindings()
+	saved := Bindings{}
+	loadJSON(s, storageKeyBindings, &saved)
+	if _, ok := saved[ActionRotate]; !ok {
+		// The bindings saved before rotation have B for diving and jumping
+		// by default, which now rotates.
+		for _, a := range []Action{ActionDive, ActionJump} {
+			if bs, ok := saved[a]; ok {
+				saved[a] = removeBinding(bs, gamepadBinding(gamepadButtonB))
+			}
+		}
+	}
+	for a, bs := range saved {
+		bindings[a] = bs
+	}
+	return bindings
+}
+
+func removeBinding(bindings []Binding, b Binding) []Binding {
+	var bs []Binding
+	for _, c := range bindings {
+		if c != b {
+			bs = append(bs, c)
+		}
+	}
+	return bs
+}
+
+// settings is the persisted state of the settings other than the bindings.
+type settings struct {
+	Muted         bool `json:"muted"`
+	AnalogTrigger bool `json:"analogTrigger"`
+	// Language is empty to follow the locale of the platform.
+	Language Language `json:"language,omitempty"`
+	// Pack is the name of the installed asset pack, or empty for the
+	// built-in assets.
+	Pack string `json:"pack,omitempty"`
+}
+
+func (g *Game) saveSettings() {
+	saveJSON(g.storage, storageKeySettings, &settings{
+		Muted:         g.soundIcon.isMuted,
+		AnalogTrigger: gamepad.analogTrigger,
+		Language:      g.language,
+		Pack:          g.pack,
+	})
+}
+
+// UseAnalogTriggerDive sets whether the analog triggers scale the dive over
+// the saved setting, e.g. for a command line flag.
+func (g *Game) UseAnalogTriggerDive(enabled bool) {
+	g.analogTriggerFixed = true
+	SetAnalogTriggerDive(enabled)
+}
+
+func (b Binding) label() string {
+	switch b.Device {
+	case DeviceKeyboard:
+		return strings.ToUpper(ebiten.Key(b.Code).String())
+	case DeviceMouse:
+		return tr("settings.mouse", strings.ToUpper(mouseButtonNames[ebiten.MouseButton(b.Code)]))
+	case DeviceGamepad:
+		return tr("settings.pad", b.Code)
+	}
+	return ""
+}
+
+func bindingsLabel(bindings []Binding) string {
+	if len(bindings) == 0 {
+		return "-"
+	}
+	labels := make([]string, 0, maxBindingLabels+1)
+	for i, b := range bindings {
+		if i == maxBindingLabels {
+			labels = append(labels, fmt.Sprintf("+%d", len(bindings)-i))
+			break
+		}
+		labels = append(labels, b.label())
+	}
+	return strings.Join(labels, " ")
+}
+
+// rebindState is the action waiting for a new input in the settings menu.
+type rebindState struct {
+	action Action
+	button *Button
+}
+
+// updateRebind binds the input pressed in the current tick to the action. The
+// new input replaces the bindings of the same device, so rebinding a key keeps
+// the gamepad buttons.
+func (g *Game) updateRebind() {
+	b, ok := justPressedBinding()
+	if !ok {
+		return
+	}
+	if b == mouseBinding(ebiten.MouseButtonLeft) && input.IsMouseClaimed() {
+		// Clicking on UI is not a binding. Clicking on the button again
+		// cancels rebinding.
+		return
+	}
+
+	action := g.rebind.action
+	bindings := []Binding{b}
+	for _, old := range actions.bindings[action] {
+		if old.Device != b.Device {
+			bindings = append(bindings, old)
+		}
+	}
+	actions.bindings[action] = bindings
+	g.rebind.button.Text = bindingsLabel(bindings)
+	g.rebind = nil
+	saveJSON(g.storage, storageKeyBindings, actions.bindings)
+}
+
+// nextLanguage switches to the next language and rebuilds the open menus in
+// it.
+func (g *Game) nextLanguage() {
+	l := languages[0]
+	for i, lang := range languages {
+		if lang == language && i+1 < len(languages) {
+			l = languages[i+1]
+		}
+	}
+	g.language = l
+	setLanguage(l)
+	g.saveSettings()
+	g.rebuildMenus()
+}
+
+func (g *Game) newSettingsMenu() *Menu {
+	rows := NewVBox(menuSpacing, NewLabel(tr("menu.settings")))
+	var focusables []Element
+	buttons := map[Action]*Button{}
+	for a := Action(0); a < actionNum; a++ {
+		a := a
+		button := &Button{Text: bindingsLabel(actions.bindings[a])}
+		button.onClick = func() {
+			if g.rebind != nil && g.rebind.button == button {
+				g.rebind = nil
+				button.Text = bindingsLabel(actions.bindings[a])
+				return
+			}
+			if g.rebind != nil {
+				g.rebind.button.Text = bindingsLabel(actions.bindings[g.rebind.action])
+			}
+			g.rebind = &rebindState{action: a, button: button}
+			button.Text = tr("settings.pressAnyInput")
+		}
+		buttons[a] = button
+		elem := NewElement(button)
+		focusables = append(focusables, elem)
+		rows.Add(NewHBox(menuSpacing, NewLabel(tr("action."+a.String())), elem))
+	}
+
+	analog := NewToggle(tr("settings.analogTrigger"), gamepad.analogTrigger, func(value bool) {
+		SetAnalogTriggerDive(value)
+		g.saveSettings()
+	})
+	reset := NewButton(tr("settings.reset"), func() {
+		g.rebind = nil
+		actions.bindings = DefaultBindings()
+		for a, b := range buttons {
+			b.Text = bindingsLabel(actions.bindings[a])
+		}
+		saveJSON(g.storage, storageKeyBindings, actions.bindings)
+	})
+	lang := NewButton(languageNames[language], g.nextLanguage)
+	back := NewButton(tr("menu.back"), g.closeMenu)
+	rows.Add(analog, NewHBox(menuSpacing, NewLabel(tr("settings.language")), lang))
+	focusables = append(focusables, analog, lang)
+	// The packs can be switched only if any are installed.
+	if len(listAssetPacks()) > 0 {
+		name := g.pack
+		if name == "" {
+			name = tr("settings.packDefault")
+		}
+		pack := NewButton(name, g.nextAssetPack)
+		rows.Add(NewHBox(menuSpacing, NewLabel(tr("settings.pack")), pack))
+		focusables = append(focusables, pack)
+	}
+	focusables = append(focusables, reset, back)
+
+	rows.Add(NewHBox(menuSpacing, reset, back))
+	return NewMenuWithContent(rows, focusables...)
+}
@@ -0,0 +1,162 @@
+package game
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hajimehoshi/ebiten"
+	"github.com/rakyll/statik/fs"
+)
+
+// Waveform is the shape of the oscillator of a sound effect.
+type Waveform string
+
+const (
+	WaveSquare   Waveform = "square"
+	WaveTriangle Waveform = "triangle"
+	WaveSaw      Waveform = "saw"
+	WaveNoise    Waveform = "noise"
+	WaveSine     Waveform = "sine"
+)
+
+// SFX is the parameters of a synthesized sound effect, in the spirit of sfxr.
+// Frequencies are in Hz and times in seconds. The effects of the game are
+// defined as JSON files of these in assets/sfx.
+type SFX struct {
+	Wave Waveform `json:"wave"`
+	Freq float64  `json:"freq"`
+	// MinFreq and MaxFreq limit the slide if they are not 0.
+	MinFreq float64 `json:"minFreq"`
+	MaxFreq float64 `json:"maxFreq"`
+	// Slide is the change of the frequency per second, and DeltaSlide is the
+	// change of Slide per second.
+	Slide      float64 `json:"slide"`
+	DeltaSlide float64 `json:"deltaSlide"`
+	// VibratoDepth is the ratio of the frequency which the vibrato swings by.
+	VibratoDepth float64 `json:"vibratoDepth"`
+	VibratoSpeed float64 `json:"vibratoSpeed"`
+	// Duty is the ratio of the high part of the square wave, and DutySweep is
+	// its change per second.
+	Duty      float64 `json:"duty"`
+	DutySweep float64 `json:"dutySweep"`
+
+	Attack  float64 `json:"attack"`
+	Decay   float64 `json:"decay"`
+	Sustain float64 `json:"sustain"`
+	Release float64 `json:"release"`
+	// Length is the time before the effect is released by itself. If it is 0,
+	// the effect is held until released.
+	Length float64 `json:"length"`
+	Volume float64 `json:"volume"`
+}
+
+// ParseSFX parses a sound effect in JSON. The parameters it doesn't have are
+// a square wave at half duty in the full sustain.
+func ParseSFX(data []byte) (*SFX, error) {
+	s := &SFX{
+		Wave:    WaveSquare,
+		Duty:    0.5,
+		Sustain: 1,
+		Volume:  0.3,
+	}
+	if err := json.Unmarshal(data, s); err != nil {
+		return nil, err
+	}
+	switch s.Wave {
+	case WaveSquare, WaveTriangle, WaveSaw, WaveNoise, WaveSine:
+	default:
+		return nil, fmt.Errorf("game: unknown wave %q", s.Wave)
+	}
+	if s.Freq <= 0 {
+		return nil, fmt.Errorf("game: freq must be positive: %v", s.Freq)
+	}
+	if s.Sustain < 0 || s.Sustain > 1 || s.Volume < 0 || s.Volume > 1 {
+		return nil, fmt.Errorf("game: sustain and volume must be in [0, 1]")
+	}
+	if s.Attack < 0 || s.Decay < 0 || s.Release < 0 || s.Length < 0 {
+		return nil, fmt.Errorf("game: times must not be negative")
+	}
+	return s, nil
+}
+
+func secondsToDuration(s float64) time.Duration {
+	return time.Duration(s * float64(time.Second))
+}
+
+func (s *SFX) envelope() Envelope {
+	return Envelope{
+		Attack:  secondsToDuration(s.Attack),
+		Decay:   secondsToDuration(s.Decay),
+		Sustain: s.Sustain,
+		Release: secondsToDuration(s.Release),
+	}
+}
+
+// Ticks returns the number of ticks the effect lasts including the release,
+// or 0 if it is held until released.
+func (s *SFX) Ticks() int {
+	if s.Length == 0 {
+		return 0
+	}
+	return int(math.Ceil((s.Length + s.Release) * float64(ebiten.MaxTPS())))
+}
+
+// fallbackSFX is a beep for the effects which failed to load.
+var fallbackSFX = SFX{
+	Wave:    WaveSquare,
+	Freq:    440,
+	Duty:    0.5,
+	Sustain: 1,
+	Release: 0.02,
+	Length:  0.1,
+	Volume:  0.3,
+}
+
+// sfxDefs are the built-in sound effects by the names of the sounds.
+var sfxDefs = map[string]*SFX{}
+
+func init() {
+	for _, name := range packSoundNames {
+		s := fallbackSFX
+		sfxDefs[name] = &s
+	}
+}
+
+// loadSFX reads the sound effects in assets/sfx. The ones missing keep the
+// fallback beep.
+func loadSFX() error {
+	statikFs, err := fs.New()
+	if err != nil {
+		return err
+	}
+	var failed []string
+	for _, name := range packSoundNames {
+		f, err := statikFs.Open("/sfx/" + name + ".json")
+		if err != nil {
+			failed = append(failed, name)
+			continue
+		}
+		data, err := ioutil.ReadAll(f)
+		f.Close()
+		if err != nil {
+			failed = append(failed, name)
+			continue
+		}
+		s, err := ParseSFX(data)
+		if err != nil {
+			failed = append(failed, fmt.Sprintf("%s (%v)", name, err))
+			continue
+		}
+		sfxDefs[name] = s
+	}
+	if len(failed) > 0 {
+		sort.Strings(failed)
+		return fmt.Errorf("invalid or missing sound effects: %s", strings.Join(failed, ", "))
+	}
+	return nil
+}
@@ -1,11 +1,7 @@
 package game
 
 import (
-	"errors"
-	"io"
 	"log"
-	"math"
-	"time"
 
 	"github.com/hajimehoshi/ebiten/audio"
 )
@@ -14,64 +10,100 @@ const (
 	sampleRate = 44100
 )
 
-var audioContext *audio.Context
+// Names of the sounds, which are also the names of their effects in
+// assets/sfx and of the sounds asset packs can override. The jump sound loops
+// while the player is in the air, and the record sound is played for every
+// note of the records at their pitches.
+const (
+	soundJump    = "jump"
+	soundLand    = "land"
+	soundRecord  = "record"
+	soundWipeout = "wipeout"
+	soundPickup  = "pickup"
+)
+
+var packSoundNames = []string{soundJump, soundLand, soundRecord, soundWipeout, soundPickup}
+
+// packSounds are the WAV files of the sounds overridden by the asset pack.
+var packSounds map[string][]byte
 
-func init() {
-	var err error
-	audioContext, err = audio.NewContext(sampleRate)
+func isPackSound(name string) bool {
+	for _, n := range packSoundNames {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// packSound decodes the sound of name in the asset pack if it has one, and
+// returns its length in bytes.
+func packSound(name string) (audio.ReadSeekCloser, int64, bool) {
+	data, ok := packSounds[name]
+	if !ok {
+		return nil, 0, false
+	}
+	s, length, err := audioOut.DecodeWAV(data)
 	if err != nil {
-		log.Fatal(err)
+		log.Println(err)
+		return nil, 0, false
 	}
+	return s, length, true
 }
 
-type JumpSound struct {
-	player *audio.Player
-	timer  *time.Timer
+// shortSoundTicks is the length of the short sounds, and also of the jumps
+// too short to play the jump sound.
+var shortSoundTicks = msToTicks(100)
+
+// tone is a player of the sound of name, which is synthesized from its
+// effect at freq, or the WAV file of the asset pack if synth is nil.
+type tone struct {
+	player soundPlayer
+	synth  *Synth
 }
 
-func NewJumpSound() *JumpSound {
-	s := &JumpSound{}
+func newTone(sounds *SoundScheduler, name string, freq float64) tone {
+	if stream, length, ok := packSound(name); ok {
+		var src audio.ReadSeekCloser = stream
+		if name == soundJump {
+			src = audio.NewInfiniteLoop(stream, length)
+		}
+		return tone{player: sounds.NewPlayer(src)}
+	}
+	synth := NewSynth(sfxDefs[name], freq)
+	return tone{player: sounds.NewPlayer(synth), synth: synth}
+}
 
-	var err error
-	s.player, err = audio.NewPlayer(audio.CurrentContext(), s.wave(440))
-	if err != nil {
-		log.Println(err)
-		return nil
+// JumpSound loops while the player is in the air.
+type JumpSound struct {
+	sounds *SoundScheduler
+	tone   tone
+}
+
+func NewJumpSound(sounds *SoundScheduler) *JumpSound {
+	s := &JumpSound{
+		sounds: sounds,
 	}
+	s.tone = newTone(sounds, soundJump, 0)
 	return s
 }
 
 func (s *JumpSound) Start() {
-	if s.player.Current() != 0 {
-		s.player.Rewind()
-	}
-
-	// Do not play sound for a short (< 100ms) jump
-	s.timer = time.AfterFunc(100*time.Millisecond, func() {
-		s.player.Play()
-	})
+	// Do not play sound for a short jump
+	s.sounds.Play(s.tone.player, shortSoundTicks)
 }
 
 func (s *JumpSound) Stop() {
-	if !s.timer.Stop() {
-		s.player.Pause()
-	}
+	s.sounds.Cancel(s.tone.player)
+	s.sounds.Release(s.tone.player, s.tone.synth, 0)
 }
 
 func (s *JumpSound) SetVolume(volume float64) {
-	s.player.SetVolume(volume)
+	s.tone.player.SetVolume(volume)
 }
 
-func (s *JumpSound) wave(freq float64) *Wave {
-	p0 := 0.15
-	p1 := 0.3
-	x0 := (1 - p1) / (p1 - p0) * sampleRate
-	x1 := p0 * x0
-
-	return NewWave(freq, func(x, lambda float64) float64 {
-		r := (x + x1) / (x + x0)
-		return math.Sin(2 * math.Pi / lambda * x * r)
-	})
+func (s *JumpSound) Close() error {
+	return s.sounds.Close(s.tone.player)
 }
 
 var baseFreq = []float64{
@@ -85,22 +117,24 @@ var baseFreq = []float64{
 }
 
 type NewRecordSound struct {
+	sounds  *SoundScheduler
 	freqIdx int
 	octave  int
-	players map[float64]*audio.Player
+	tones   map[float64]tone
 	volume  float64
 }
 
-func NewNewRecordSound() *NewRecordSound {
+func NewNewRecordSound(sounds *SoundScheduler) *NewRecordSound {
 	s := &NewRecordSound{
+		sounds:  sounds,
 		freqIdx: 0,
 		octave:  1,
-		players: make(map[float64]*audio.Player, 32),
+		tones:   make(map[float64]tone, 32),
 		volume:  1,
 	}
 	for _, freq := range baseFreq {
 		for i := 1; i <= 3; i++ {
-			s.createPlayer(freq * float64(i))
+			s.createTone(freq * float64(i))
 		}
 	}
 
@@ -108,18 +142,7 @@ func NewNewRecordSound() *NewRecordSound {
 }
 
 func (s *NewRecordSound) Update() {
-	freq := baseFreq[s.freqIdx] * float64(s.octave)
-	p, ok := s.players[freq]
-	if !ok {
-		p = s.createPlayer(freq)
-	}
-	if p.Current() != 0 {
-		p.Rewind()
-	}
-	p.Play()
-	time.AfterFunc(100*time.Millisecond, func() {
-		p.Pause()
-	})
+	s.play(baseFreq[s.freqIdx] * float64(s.octave))
 
 	s.freqIdx++
 	if s.freqIdx >= len(baseFreq) {
@@ -128,78 +151,92 @@ func (s *NewRecordSound) Update() {
 	}
 }
 
+// Chime plays a short arpeggio without changing the progress of Update.
+func (s *NewRecordSound) Chime() {
+	for i, idx := range []int{0, 2, 4} {
+		s.playShort(s.toneAt(baseFreq[idx]*2), i*shortSoundTicks)
+	}
+}
+
+func (s *NewRecordSound) play(freq float64) {
+	s.playShort(s.toneAt(freq), 0)
+}
+
+func (s *NewRecordSound) toneAt(freq float64) tone {
+	t, ok := s.tones[freq]
+	if !ok {
+		t = s.createTone(freq)
+	}
+	return t
+}
+
+func (s *NewRecordSound) playShort(t tone, delay int) {
+	s.sounds.Play(t.player, delay)
+	s.sounds.Release(t.player, t.synth, delay+shortSoundTicks)
+}
+
 func (s *NewRecordSound) Reset() {
 	s.freqIdx = 0
 	s.octave = 1
 }
 
 func (s *NewRecordSound) SetVolume(volume float64) {
-	for _, p := range s.players {
-		p.SetVolume(volume)
+	for _, t := range s.tones {
+		t.player.SetVolume(volume)
 	}
 	s.volume = volume
 }
 
-func (s *NewRecordSound) createPlayer(freq float64) *audio.Player {
-	p, err := audio.NewPlayer(audio.CurrentContext(), s.wave(freq))
-	if err != nil {
-		log.Println(err)
-		return nil
+func (s *NewRecordSound) Close() error {
+	for _, t := range s.tones {
+		if err := s.sounds.Close(t.player); err != nil {
+			return err
+		}
 	}
-	p.SetVolume(s.volume)
-
-	s.players[freq] = p
-	return p
+	return nil
 }
 
-func (s *NewRecordSound) wave(freq float64) *Wave {
-	return NewWave(freq, func(x, lambda float64) float64 {
-		return math.Sin(2 * math.Pi / lambda * x)
-	})
-}
+func (s *NewRecordSound) createTone(freq float64) tone {
+	t := newTone(s.sounds, soundRecord, freq)
+	t.player.SetVolume(s.volume)
 
-type Wave struct {
-	freq float64
-	f    func(x, lambda float64) float64
-	pos  int64
+	s.tones[freq] = t
+	return t
 }
 
-func NewWave(freq float64, f func(x, lambda float64) float64) *Wave {
-	return &Wave{
-		freq: freq,
-		f:    f,
-	}
+// EffectSound is a sound effect played once at a time, e.g. on landing.
+type EffectSound struct {
+	sounds *SoundScheduler
+	tone   tone
 }
 
-func (w *Wave) Read(buf []byte) (int, error) {
-	n := len(buf) / 4
-	for i := 0; i < n; i++ {
-		val := w.f(float64(w.pos), float64(sampleRate)/w.freq)
-		b := int16(val * 0.3 * math.MaxInt16)
-		idx := i * 4
-		buf[idx] = byte(b)
-		buf[idx+1] = byte(b >> 8)
-		buf[idx+2] = byte(b)
-		buf[idx+3] = byte(b >> 8)
-		w.pos++
+func NewEffectSound(sounds *SoundScheduler, name string) *EffectSound {
+	return &EffectSound{
+		sounds: sounds,
+		tone:   newTone(sounds, name, 0),
 	}
-
-	return n * 4, nil
 }
 
-func (w *Wave) Seek(offset int64, whence int) (int64, error) {
-	switch whence {
-	case io.SeekStart:
-		w.pos = offset
-	case io.SeekCurrent:
-		w.pos += offset
-	case io.SeekEnd:
-		return 0, errors.New("SeekEnd: End of wave is not defined")
+// Play plays the effect from the start. A WAV file of the asset pack stops at
+// its end, and a synthesized effect after its length and release, or after a
+// short sound if it has no length.
+func (s *EffectSound) Play() {
+	p := s.tone.player
+	s.sounds.Play(p, 0)
+	if s.tone.synth == nil {
+		return
 	}
+	if t := s.tone.synth.sfx.Ticks(); t > 0 {
+		s.sounds.Pause(p, t)
+	} else {
+		s.sounds.Release(p, s.tone.synth, shortSoundTicks)
+	}
+}
 
-	return w.pos, nil
+func (s *EffectSound) SetVolume(volume float64) {
+	s.tone.player.SetVolume(volume)
 }
 
-func (w *Wave) Close() error {
-	return nil
+func (s *EffectSound) Close() error {
+	return s.sounds.Close(s.tone.player)
 }
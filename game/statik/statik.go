@@ -8,6 +8,6 @@ import (
 )
 
 func init() {
-	data := "PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x15\x00	\x00gopher-acceralate.pngUT\x05\x00\x01\x80Cm8t\xb1eT\x1c\xde\x92\xee\xdd\x81\xe0\x1a\x82\x06w\xa7qwwH\xe3\xee\x0e\xdd@\xe3\x10$\xb8\xbb\xbb\x05w\x87`\xc1%\x04w\xd7\xe0\xee\xf6\xae\xff\x9c3\xef\xcc\xba\xf7\xdcZk\x7f\xd8U\xf5\xab\xda\xfby\xc2U\x95e\xd0\x90\xbf \x03\x00\x0049YI\x10\x00\x00\x10\x05\x00\x00\xac\x88\xf0\x00\x00 _\xc1\x87\x14\x00@\x95\xb3\x91\x90P\x95\x93\x90 Su\x86X\xda\xd8[\x00\x00\xb2\xe1\xc9Z\x08\xeaj#_r\xf4@r\xd6\x01\x88\xb2H\x9d\xe1\xa0\"\x05\xa4|)\xcc8\x86ED9\xb2\xfc\xfe4L\xc9\x93\xd1z\xe4j\x03\xc9\x9e\x90\xea\x08\xbav\\\xd1\xf9\x88\xf6y\xa9\xba\x8e\x14<\x8b\xaf::x(\xdc\xea\xebE\xfe\xd5\xde\xeb\xde\x07\xaf\x07\xe3\xab\xc0\xf7\x97\xebWj\xe8\xc1\x04 Ok,Q\xfe\xfa\xeb7\x002Up}\x7f)\xeb|\xd0\x0d*n\xd9g2\x7f\x80\x8b\xbf\x88\xf1\xc5\x833\xbd\x0099\xae(\x81\xcf\x9b\xc1\xfaj\xd0\xbcju[Y\xeb\x1b\xdf\x9b\xd1\x80(Y\xd2\xf5\x07Q,\xb2	\xac\xe1G8L\xd2\x8f\x00\xe0\x9e\x82E0\x00\xf35o\xddM:_=\x98V\xcc\x9e*\xf0\x838\x93\xc6\x16\x11\x1a\x8b\xc6\x16\x13Z5h\x85\x8a\xd6\x99l\x1d\x0d\x90>\xb83\xe2\x0c\x98t\x08\xf0\xd2\xfc$\x82p\xf5w=d\xd1\x80\x02S\x010I)A4\x8ew\xf9\x11SFB\xb0 \xcf8\x03YB`3\xe9\x16Geb\x83\xb7!\x84\xd7\xf3g\x16y\xe5\x88\x14\xf3\xaec\x9d~\xc6\x9f\xccG\xbf\x94\x1d?\x05\xb1.\xd3\xc9PX{\xcex&\xfe\xaf\xc9\x1c\x95+\xa7?m\xd8\x18\x14\x03[e\x12p\xf00\xea\xc8\xfb\xcf\xfba\x9a\x84\xfa\xe94\x8a\xc2\x94\xa7\x16\x0b\xf7}\xf8\x10\xe6QY\xc9\xdd\xf0.~\x99\x91q\x87\xf2\x82\x12H\xf1*\xe6\xac,\")\xdb0\xfbp\xbe\xe3F\xa2\xb4\x8d,\xb7E\x06_\x85Q1[U\xd9\xeb\x9a\xbe\x90M\xa48-.\xb7E\x0b\xa6/\xd7\x8c\xbe5R\x00\x83\xab\xf6\xba\x99\xb1\x9c\x11\x1e\x8dR\xc3\x12f8\xd9\xccf)\x9cl\x89\xfa\xdb19\xf6<\xe5\xd8\xbe\x10\x8eWK\xf6\x87\x136\xc6\xd3ci\\\xae\x88\x11\x91%I\x06\xdb<\xd4\xd1/\xd5\x0fKVuV\x8a\n\xa7\x01\x9b\xb5\xa9\xf6\x07m\x9c\\\xf3\xe9\xf8t\xc7\x1a2\xff\xa8\x0dA`\x9c\"U\x7f)\x13\xec~\x1a\xa5\xedG\xdf\xa7\xc0\x0c\x91\xb1v\x1e\xaaF\xa4\xa6_\xf6a+nde\xfa*p_\xcc)\"f\x17\xab\x16\xfc\xf8\x95\xa1\x13\xad\x7f\xff\xaa\x80\xeaT_\xb0\x15\x93E\x16M\x84\x84\x96YQv\xbe?\xdcj\xefj\x90\xc6z\xbb7R\x8ci\xd3\xdf\xb1\x07\x06y\xb1\xbe\x873\x0e\xa5\xffT\xe8\xb7\xd0E\xaa%\x15\xd6\x85?\xea.\x80\xdcq\xc6\x16\xde\xaa\xa8L\x86\xaaV\x05\x86zS%,HT\x98\x13\xa8(\xc4\xf0QJ\xf5\xddF\xb6\xe2pM\xb4\xd9\xabQV\x88\x12C0\xa4\x15\xd0\xef\xef\xf3\xa0\x97\x9fCMp\x95\x82\xec\x1b\xfa\xe5R\xe4\xe7\xb5I\xe5cm\xd9\xe0[\x99\x99\x84R\xa5f!\xfe\xae\xc5!\x9a.\x16e\xd8V\xbf\xb5)\xd5\x14\x01O_\xe0\xa5\xa8 \xee]~\xad\xe7\xb6o\xeb\xbbq\xa3\xd1	R,\x12q\x90L\x10\xe1G\xea\xad	\xf5K\xf1%\x9e\xc7\xda\x1e\xde\xbc \x12\x8a&>\xabo\xd9\x1cg\x00\x9d\xceKVA\xc4+	pVx\xe6\x07\x1e\x1f\xce\x9b\xfeA\x89<\x08\xa4t\xcf\x9aR\xcb\xe2\x9d\xf9~\xc3\xf9\xa1\xfcHW B\x00\xae\x18\xb50\x08\x94\xd5\x95]v\\p,\xd4_P~\xb8\x803\xdd\x9f\xc1\xa5\xae519\xdc\xf2\xc6g\xa4\xa1\x0b\xc8e\xdb\xd05\x8d\xac\x83]\xd49\xa1r#\x11\xf8\xce\x05\x8b\xebFd\xd7\xcbb\"\xa3\xe1a\x8d\xf3Zm(\x15\xb8\x8e5w\xb9+Fr)\xf2r\xd9(\xac\x91\x9c\xd4\xc1\xd3\xea\xde\xe17\x1b\x85\x83\x1c\xa9\xf1L\xb9\xa9\x988\x91\x14\x0e\x95\x0cf\xe2^}\x0cf\xaa\x0e\x12q\xfe86\xb3\xd6G\n\x98s\x97lP\xea4-\xef\xc6@\xbc\xfe\x85\xb7F\xc1\x00\x10K\x08\x08\x08\xa04ra#\x05\xf0Z\xa3\x014\x1e\xa5\x0e<p\xc0\x08\x8e5\"-	5q86.\xad\xe5\x94\x00\x86\xc9@H\x12}\xed\xc7\xe6\x1e\xa4\xfd\x1d$\x1fQ2D\xb8O\xc6La\x08#d\xc4\x8a\x01\x1a\xb8\xbd\xb7L\xb0\x1c=\xf6\xb5\x80\xa4\x00\xe4Z\x98J\xb89\xe3\xcf\x8b\xc8n\xdf\x85\x8cE\xac\xe1ez\xe4.PZ\x11\xab\xfd	7Y\xf4\x85\x0c6	\xfe\xc2ADE\xbe\x93Q\x05\xc5\xb0b\xaa\xf5#\xd7\xc9\xe8\x7fR\xe9\xc7@\x92\xa6\x88\xd3\xa6\xfa$'\xc3\x1eO$\xf9\x9d.ni\x98\xa2\x08F'X\x9dR/\xcek\x97v\x97\xd5\x19\x8b/\xd6\x85\xcc=.T\x96\xe7\n\xeb\xd1\xdf\x94\x0e\x8dK\x9cY\x15\xc1\x05\xfb(\xb4~\n\x9f\x93 ;j?of\n\xab\x11\xdb D3\xdf\xf5/\xb8\x11I(\xdcP\x12\x92'\x7fC\xb0\x1c\xfaA\x11\xc1\xcct>\x8dp?\xd4\xa9\xd6\x83	vl\xd0|Q\xbf\x92\xa8\xa2\xff\xa8V\xba\x12\xe7\xed\xcf\x02+\xf5|`\xe7P8/\xac\xd5\x16\xd8\x11Nf\x87\x9e\x9747\xe6\xcf@\xea\xf6\xdc\x83\xf2O$\x91h\xa3>\xd2\xed\xce\x07+\x98lceG\xea\x8f\x98\xadAHAD\xbfP\xc5	\xf2\x13j\xb3\x1d\xc3\x1c\xe1\xbb\x94\x0c\x82x$)\xe2\xe5\\\xa8\x9e~\xb5\xb0e\xa7)dI\xe9\xcb\xaf\x8a\xae*\x08\x8a\x9d\x90\xb9a=\xcbR%\xd0\x8f$cKF\xd0^\xca\x06\x17\xb1O{M\x83\xa7\x9d\xa65@x\x87\xc4:\xa1\xf6RwT2	\x1c\xc3XW\xe2\xce\xd4\x9ex\x8f\xf8\x8f\x0c\x8fHm\xb9\x05*u%\x1fU\xb7\xe9aF\x15G\x9dG\xb9\xe5\x19\xf7\xd8\x8b#\xa7U\xb8\xf2\xf8A\x81\xa5#\xb3\xfaG0\xb68M\xf1^!^\xb1O\xf222C\xd2CFV?c\xb4\xad\xba\x94\x93'\x84\xb3\xa1\xfc\x18\xae\xeb3\xa86,\x9c\xe8\x0eXJbz\xd2+b\x02b\x1d\x12\xdeR%3\xc4\x7f\xf3oP\xbd\xc8\xbf\x11t\xe6\xf8F\xbc\xf9\xbf)\xa7\xe6\x0b\x80R+\x18'\xb3K\xf4\nW\x8a\xbc5\xee\xdbH\xa4\xfe\x18\xc6V\xf1H\xd5H\xd6\x80\xce\xfd\xd7-:I\xbeEt\x05\n\xcb\xe4\x96\x88huK\xbf\xd7\xbe1\xbf\n\xc3\x86\x04\x84\x8a\xfd\x14\x0b\xe8\x05\x05\x1a\xc0\x1f\xa0\xe5|I@%F\xa5D\x85\x0b)\x0e\xd1CS!pcg\x0da\x0e\xec\xdb\xe4\xc0ekf\x9b`m\x8f\n\x93\x8e\x91\xd6/\xd4/D.\x14\x92>\x95\xee\x1c\x0c\x19,\x8cV\x8b\x8e\x8c\xb6\x8b\x8a\xfd\xc2\x98\x8e?\x130b\xb1\xcf\x91\xcaq\xcf\x01a\x17Am\xc0\x1f$\xa1!j6\xd7\xde\xa9\xad\xe8[\xcf@\xe5A\xe2\xfa\x94\x91\x94y\xc2\xd5fr\xd5\x9bd\xf1\xc5$\xc3\xaa}\xbaq\xe9\xa6\x8b\x00\xbco\xca\xbdn\x1f\xb2$\xdd\xc0a)\xd4\x17\x1d\x82\x80~\x80\x01$\xed\xf2{\x80\xec\x97\xb6Ez\x16<2]W\xed\xa7\x8e?311\x170\x15\xe1\x1e3+\xeb\xdch\xb33K0C\x99\x17\xb4I\x1aN\x9aM\xb5\xe1\xf4\xc2\xab\xe6\xb5\x89[\x9e\x02-\xc6M\x1b\xaet4*g*\x0d\xed\xa0\xc7\xd6v\xc1\xb7\xb8\xc7n\xc7\xbe\x842\x99\xd8\x99\xc1\xdc\xde\x0eg+ \x87W\xfb\x06\x87\x85\xc5\xe6\xa5\xbbe\x9d\xa5\xe8\x86L\x87\x05\xe3\xfbL\xa1\x81\xf5?\x86\xe6\xdd\x13o\x95\xafz\x082\xa1\x9c\xa1\xe7\xc4\x19\xc49\xfc\x19\xbc\xce\xc4\nD\x84D\xe7|S\xd9\xe2Y?\x04\xe6\xf8\x0c\xdci\xf8\x9f\xf9\x0e\xf8 \x8e]\xdb\xdf\xdb=:\xc2\xf4m\xf5Y:\n\xaa\x85\xabM\x17\xd0\xaa\x89)\x11)_dd\xa5g\xa3[\xf7\xa4\xa1f\x1d8m\\\xadBD#D\x04\x02\xce[\xc3\xfb\xf1\x7fd\x96\xa2G\xf1\x9b\x9cg\x97\xbe\xe6\xaeu\xae]\xaf\xb5\xb4m\xdc\xb2\xde\xe0\x1f\xd7M\x16\xce\xed\x14\x97D\xd2n4\x0c^\xb7\xe3\xad1N\xdb\xe5hE.DNU\x17\x06\x17\xf2\xc4t\x9d\xfe\xbe 9x\xedt\xcf\xbc\xa7%5\"MW)Ti\xa9\xc9\x86\xfc\x80T\x9d3\x8bPv\x7f\xec.\xf6=xAz3y\xbb~\x89}\xe8\xc6\xb8@\xa2C]$\xbd\xd0\xca\xd336\xdc\xac\xee)1#\x1aB\x1c\xb2'\x9c!0\xff\xdc\x8f\xb2\x8cU\x84j	g\x05\x0fA\xd7\x0c\xd1\x0e\xbd\x10?\x12'	^\x0eA\x16o	\x156\x11h\x80.u,\xfd\xe9[\xed37	\xfd\xb5\xee\x0c\xc3\x0b\xe5\xa5\xfdUwQzquq\x87\xd8\x17\x96\x14v\x14\x86\x10\xcf\x1d\xcf\x17\x8f\x16\xdf\xf9u\xfdkC\x81\xb2\xe4\xe7\x02\xb3\x02-\xe9\x8dm]\xc7\xc7U/\xf0\xb9\xf6F\x1dt0'&\x7f\x80j@}H0\xc25<y\xa09Fb(\x9a\xb8\xc5k\xb5\x93\xf7\x99WN\x1f*\x18v0X\x13\xd3\x9d\xbb\x99\xc6*\xb4up\xd9\x7f\x89\xb4c7(\xa2|#\x9b\x95\xed\xc1\x1d\x1e\xcf\xc9e\xa35\x9d\xb9[\xbb\xbb7\xe2\xae\x96\x05\xc2\xa6w(\xf3*K\x04\x1d\xabm\xfc\x08.\xa9\x92WS\xc6\xe72\x99\x08\x8a<\xb4<\xdc\xbeIA\x05I\xdb\x0e\xdeEu\x85\x7f\xfa\xbc\xa0|\x92\xb1+Tf?\xfd{$&1\x9267\xd7\x94\x9b\xdaot\xaa\xccK\xcdS\x0d\xc3\x1c\x8b}\x86\xdd\xdd\xc2ww\xe0\xfa\xc8\xe3\x9b\xcej\xe5r\xa5\xa1phw\xcc\xbb\"&\x836\xe5_\\x$-\xa4\\s\x04\x8b\x9aQ\xdf\x8c\xcfI\x0d\xfc%z\xd8\x14\xe5\xd8\xb7a|\xdb\xb6\x97\x91\xab\x7fW\x95\xda \xd7\xdb\xd7n\xbbh\xbb%{\xe7\xccdx\xe6\xb8D)\xf8\xf2WT%%:\xca\x8c9\xf3\xe4\xae\xc9)r\xc93\xb0?j\xb2\xd7\x19#\xe3\x1dR)\xafF\xaeR\xc4)=\x8c\xbb\x8b\x1cA>%\x1e\xda\xd8\xfa\xeaj7`\xe9\xa3(\xd8\x01\xf8\x81\x84m\x05\x0e\x03K\x96\xf4\xc7\"\xaa\xfa\xdd!\x9d\x07\xa6\xb7\xca\xe6\xa5\xf9\x06\xbcq_\xdeP!\xab1}s\xf0\xfcj\xb3g\xf3\xb5\xfc\x81\xeb\xef\xd8\x8cn\xe8\x07W\xb1\xf5\xefFx\xed@\xfd\xa6\x8b\xdb\x82F\xa9\xaab\xdc\xe2k\x05\x81\xee\xc8\xa7\xea|\xc5\x16EM\xb7\xe3\xe7\x9es\x1e\xe6A\xbcH\xbc\x16\xe0\xa5n\xbd\xef\xc8\x81\xb3\xd8c0\xf2}\xc4hD\xac\xc2\xa3\x02\xf0e\xf3Rb\x0e\x03v\xac>\xb5	x\xaf\xd3\xe5\x04\xe7\x14l>\xf0;\x99\x9c\xd1\xbd\x9a][\xa3\xbe\x1cz\xdb:\xa7d\xa7m7\xba\xf0\xd3P\xc0\xf5\xf4qt*9s\xfe\xeb\x02\xfd<}\x85i\x85\xee\xfcJ\x95Q\xdd\x9bYS\xe9\x93\xb4\x92\xf6\xefu\xdd\xebe\xea&\x08\x0f\"\xb7\xfe\x1a~{\x9dS\xe2\xc0\x8f\x08%R\xeeH\x9e\x8du\xac\xa6\xe2\xb2\x89\xfcjgF'\xbb\xa9\xf9\xa1\x15PK\xf6\xeb\xd4\xf9\xd0\xd3\xa9\xe5\nc\xb3\xdf2\xf9R\xfejz\xeb\xb5K'\xe4\xdb+\xcb\xb5\xc1\x18\xe1$\xbc\x94\xc4\xfd\x99\\G\xcd	\xd2\xc6\xcc\xf4\xdf\xf9V\x0b \x142 \\\xabRw\x1e\x9b\xcb\xee\xd7\xde&b\xe9\xb7\xf2\xa1\x1b6\x1a\xe1\x14c\x9e\xc4\x94\x88\x01\"\x9ea\xc9}\xcb\x1d\x99\x91\xcc|\xc8L\x1c:D\x02\xb6C\xb3\x17\xba\xb9\x1e\xceu'\xaa\x13\x06Ag\x1f\xfe^\x99\xcd\x9a\x15\x0f\xc1\xc7\xa4\x12\x9d\x0b\x90\x0bMA\xd9\x0f\xcc\xadVf\x9b;Jh\xb2\xda}\xbd^\x0e\xef8\xd8\xac&^\xb1\x1e\xc7\xca\x1aN\x1a\x86\xfe\xc0e\x0b8\x0bo\xbel\xa1\xf8\xe2e\x00\x9f\xf4\xed\xdbw\xbf\x1dN\xd6\xacw\x93\xd8\xde\xdb@\xdaO\xbc\xdb\xf6\"\xde\x93\xe7c\xd8\x88\x87\xaaC\xf4\x85<\x99_\x96\x81\x0bm\x0b\xc0\xf5\xb0\x8e)'\x8e\xbd\xfdS\xbdD0=\x04\x05,\xd2\x99\xe8w0\x00\x91\xea\xbe\xdd\xc8\xf6\x8bu\xacy\xe9z{\x1dE\xd8\xc78z\xdb{\xa9\xe9\x94'\xdd\x9b\n\xdd\xc2\x00\n\xc9{\xb9\xb4$n<\x9e\xb8?\x9e\x93v\xc8\x93\xce\x90j	\xd7y\x81\xdeg\xaf\x0f\xe3\xcf\x86\xcf\x18\xfd\x14_\n\x8f\xd3ot\xfcT\x96r\xe1\xfd\xe6\x85\xdd\xbd|/j&\xbbYn\x95\xff\x9ez\x91{\xb3]8\x1f2\xceh	\x18\xc6\xfa\xae\xcd\xa7N\xdeo*\xf5f\xbe\xb5\xec\x9d+n\x9c\xfa\x9c\xdf\xbf\x9d\x846b\xa8<Y\xf9P}\xab|\xda})\xbb\x85\x8e\x1fx\xc3\xc2\xc1\xc2\x82r\xa2J\x84\x91\x8dk\xf3\x97\x96\x00?W?\x00\x84\x88\x01\x838\x1e\xbei\xa5m\x07epS\xabJs\x88/\xb4\x95m(\xe5\x03\x08\xfe\xd2\x0f\xaa\xc4\x0b|\xb4\x80E\x84\xcc>\x9fa\xb4\xcc\xa3\xd2\x1c\"dU`M\xfe\x0f\x1c\xcf\xe6\xd4\x92\x90Hx\x88`\x90;,l\x0d\x13\x80\xac	\xe6B\x8e\x9bu\xb2\xdb\xc4xs\x86\x1b\xff\x84\x18\xa0\xac\xb8\xb0\n\xd4\x10\x0fr\x0f\xfc\x9d\xcf\xe0\xd2'\xc9J\xeaC\xf9\x0eO\x9e\xe5\xd7\xb6\xa9\xf9\xf8\x19\x00\x00 9\xca\xea@\x01\x00\\\xea\x7f\xce\x079\x10\xd5\x05\x00\x00\x03o\xa3\xae\xed\xa2\xad\xa4\xc8o\x06q`11\x87\x98Z\xb0x88\x02\xfe	A\x0f~\x0f\x07G\x07\x0b\x17\x132\x0f\x07{0\x94\xdfC\x88\xe2\xbf:\xf8\xc1P\xfe\x7f\xd2@\n\xb2\xffjq\xb1\x13\xa2\xd0VR%\x93\x808[\x90q\xb1p\xb2\xb0R\x08#\x93\x91\x91	:\x9b[\xf2\x83$\xa5\xff\x8d;\x9b[\nQX\xbb\xb88\xf2\x03\x81\xee\xee\xee,\xee\x1c,\x10g+ \x1b\x1f\x1f\x1f\x90\x95\x1d\xc8\xce\xce\xecln\xc9\x0c\xf5\x04\xbb\x98x0\x83\xa1\x94\xff\x1a\xf2\xdfs$-\xa0f\xce6\x8e.6\x100\xd9?w\x13S\x88\xab\x8b\x10\x05\xc5\xbf{\xfe\x15\xffZd\xe1a\xf3?\x9b\xc0\xd0\x7f\x7f\xcb\x0c\xe2\x00\xfc\xa7\x02dca\x05\xfe'\xcc\xc5\xc6\xf2\xff\x81\xfdS\xf9\x17&\xfc?\x9c\xe0?\xc3\xf8Um<,\xecu$m\x1c,\xc0P\x1b\x08X\x98\x9d\x95\x9d]\x10\xf8\x1fK\xff\x11\xd5\xfe_(7\x1f\xeb\xffF\xb5\xff#\xfa\xcf[\xf8U\x9cm,\xc0.&\xff\x88!\xcc&\x08\xfc\xbfr\xff-\x1c\xf0\xffP\xee_\xb6\x00\xff\xed\x8b0\xb2 \xf0\xff\xf7X\x18Y\xc0\x95\xb3\x1a\x00\xc0\"\x96\x93\x14S\xb7\x86\x9b\xd3E\xf2\xd1\x1ch\x12\xd9x\x9d?AZ\x1a\x86!G\x91\xd3\xa6P\xe0\x0cC\x0cW\xa1c1\xd6#\xd9\xfe>K\xabZ\x96\xa7\xe9X\xc6\x0c\xfa:\x95\xab\xebpZqG\xafK\xc7\xa2Z,\x8aS\xfc\xb7\x82z\xd4N\n\x8e\xe2#\xa6*\x19&\x15\x99\xc9o\xf1\xae\xd3\xf5\xb7w\xe8\xb9R\x97\xa4&\xce3\xaf\xe3\xd9x\xb6\xf7\xfex\x96\xc5DV:\x90\x82\x16\xa1\xe4\xe9$\x1e\xcbixC\xf5\xdc*>\xf3\xd4\xe1\x1bW\xc5\xb2\x01\xa7'\xe0\x16\xfbx\x0d\x0c)\x14\x1c\xb2\x05s\x19\x95\xe8\x87Oc\x960\xd5\xee\xab\xc0\xf1V\xf2\xa3\xa4\x96\x0b\xec\xe9&\x15=Cn\xc1-z\xc0\x03S\x13\xddI\xda6\xec\xf1\xad\x94X\xca\xa00u\x7f9<j\x9d\x07\x12\xe7\xd6\"e\x06\xa6\xe2F-u\xc9{h\xcd\x13\xfd\xeaJ\xd8\x11\xd5\xd2\xfc,\xe5Z\xe6(\xebF6;\x86k\xdfa\xe2%n\xf6\x1f\x86?K\xbf\\ J\xbeb\xbd\x8dtm}	\xfd3b\x14\x89\x137\xb8\xc4(\xfd\xe7cqD\x91\x83+\x01wY^\\\xb0\x86\x11\xe6\xe2\xa5\x98'\xfa\x1bU\x99\x8b\x9a\xa7\xf8\xa8\xbf\xd3\xb6R\x9c\xe2&\x1d=\xcc\x15\xe4\xe3\xfb\xa5\xe8\xc8\x9d\x97\xb2\xd4\xe4Y\xe1\xa0#\xaf\xd8\x8a\x91\xd39\x8d\x96\x1bS(\x8b\xf6\x0cR\x89F	\xb8\xf2\x8bD\xc9s\xed\xf6\x0f\xbfw\xa5F\xa1\x88'\xb7\xd5\xbd(\xdc\x1f\xd0\xac~\xea\x9b\xfc\xde\xb8\x83\xd7\xcbj\xf6\xc8\x1b\xf9f\xcd\xb9\xc9\xf5{\xc2\x9a\xc0\xe4s^\x11\xd68\xf5\xe7\xa9\x07f\xf5\x91\xebJ\xfa\xc5\xacZ\x19/SL\xe4\xe2\x8e\x99\xa2X\xae\xc3\x18\xbb,d\x8e\xe4\xfd\xf8\xba\x88\x9coYW\xf5@\xb1\xabJa&\xd9>\xa4\x19\x0e\xed\x92+{\xc9\xdb(2g\xbe\x9c\xa6bK\xdb\x85\xb2J9j\"h\x89l\x19\x97\x12\x03\xddtyV\x10_\x99\x07\xbd\xbf\xb6\xdf\x90\xc03~\x86\x8a\xf7Db\xcc\xeeQ\xbf\xa8\x05\xa6\n\x9e\xfd\xb2\xd8\xdf\x8b\xce\x99\xd3\x1b\xd7\xc3q\xd7\xf3\xa4\x8a^Q\xa4\xdd\xa8d;\x8a\xb4K\xef\"\x8ae\xfd\x84\xf0\x04\x89\xe62_m\xe9q\xc6\xda\x8a\x97\xf159\x96\xa8S\xa6\xb2M|\x1b\xa9z\xc1xj\x87=\xe7,k,\x98\x10\x83\x8eM\x95\xc1E\x11 \xbb\xaa\x96|iiiXV(\xc5S3\x89<]\xaaVH\x80	\xe6\x0c'g\xecx\xf4D|T\xd8\x07:\xd4C^\xa4\x94fV\xf409\xa94\x121j\x10\x812\x12?\xe7#\xea-\xc3\xa2\xef\x87\xbb\x18\xf3\x1b\xf2Z\xd2\xaf\x8c\x9d\x8f\x96\xee\x03\xe3\xe9C8\xe5CsZ5\xddv\xd7\x9a;\xd5\xbf\xf5J\xfde\x02@O\xabP\xdb\x9c\xca\\4\xd6t\xe8\x86\xfb\xb0\x08+ \xf8\xc2\xaen\xb9\xef\xd3\xb3\xdfn+v\xc1k\xd0v\xb0U\xa8\x9c\xb5\x11\xe2\xea\x07\xce\xe4|\x9b(<\xad-\x83z\x04\xf4(\xd3\xa5\x0f\xa5\xab\x8a\x9bp\xb8\xeb>\xbd\xcc\x89h\x1fKz\xa4\x12}M\x93\xa2H\xfd8\x0ek\xd6[\xe3\xd7\x17\xa7*\x1e\xcap\xec+\x1a\xb9\xb3L\x82a?\xaa\x93*\xd1D\xb2`\x14\xe6\xfc\x9d\xec\x99gNF\x0f\xdd\x81\xa0\x87vQ\x13Q\x9f\x0f\x8b\xf1E@\x88be\x193^Um\x82+[\xcd\x1f\x0b\xc8q\xce\xd7\xc188z\xb6\x0c\x8cz\xba\xdd\xe17\xe2\xdf\xac\xe7\xf3\xc4$\xae\x80]\xadT\xf4\xb4<\x1c4mj\x8a\x8a\xce\x11\xb3\x90\x0d\x1a9>>iS\xf1\x02\xc3\xc7g\xab,;\xa3\xac\x96\x8f\x8d\xab\xf5\x15Uo\xea>\x98\xb2\x16\x8e\xf6k^Z\x89b\xaf\x84\x1f\x08B\x02B\xa8\xb67\xbe\xb6\x11t\xb1~E-\xf4f\xd4\n\xad\xec[\xe8\xf2\xb0\xaa-\x88\xc4:\x15-\x91\xab1\x04\xc5g\x94kij\x86L\x92 )v\xc8\xdc\x13\xf0\x9c-\xff\xca\xef\x83w!\xe4U\xc5j\xa8\x1f\xf8|\xaa\x96-\x87H\xc3\x8f\x80`\x0c\x17n\xb6\xd0n\xbbv\xe8f\xe7JXn\xf1\xc6e\xf6\x16\xe5fYm\x04hS{\x0c8\xdd<\xf6\x004\xfd-\xd5\xae\x13\xc74\xbfIgu\x12\x88\x99W\xa8\xa93\xf3&Y\xaf+\x1c\xdfX\xf4\xf8D\xd3\x08\xd9\xc8\xe0\xe2\x88\x91q\xcfj\xa6j4\x80!\x82>\xd6\xe4\xb4\x16\x1c\x84}r\xb2YC\x19\xd8sQ]\x04\xc0C\x83>yE\xd7V\x13\xb4\x9f\xe5\xd1\x10\x7fqE\x9atu\xf2\xd2\xe7t\xc2t\x99\x08	\x0cmj!\xf5\xc9\xbb\x0c\xdc\x10\xe6\xfab\xb4\x01\xef\xf6\xe1\xaa_\x17v\xb7^\x80\x12\xc3Q\xce\xa6Lb\xbe2\x9fQ\n\x06'\x011\n\x8e\xfd\xd4\xc2TR\x89GOV\xcb*\x1b<\xcc\x1f/\xbfS)\xb7\x82\x94\x06{\x92\xbb\\\xa2\xf2\xe8Y(m\x90%\xd2*(\xd4\xe1\xe7\x17\xae\x1cV\xf8\x07\xde\xc4\x84\x92h\xda\x00\x13?\x1c\xdf\x1e\xbe\xffJ\x1c\x8f0\xe9\xcbl\xa4\x89\x7fS\x8c{h\xdfEk\xb6\xef\xcdm\xe7s\x05\xed\xcb\xd8y\x9b\xf7\xc4\x9e\xca\x8b\x97b7\x9bY\x02O\xf7k3\xd3\xdb\xe5G\xf0\x86\xddaW	L\x8cd;Y\x10\xad\x03L\xdc\xf3\xe9\xe6\xf6\xd7\xc2g7\x16\x96\xd1h9%\xa5\xdb\x81\xa1\xa1\xf6\x82\x82U\x96\xd4\xb1\xfc6\xfd\xd1\xa1>\xdf\xee\x8f\xb1\xc43\x01\xcc\xbd))\xf0\x1e\x12\xd0Z8\xb4|U|\xc3\xad\xab\xc1\xfeAi\xf7\xa3\xa4\xac\xc5b;\x17y\xb1\x00*K\xabH M\x84x(}#	\xb7\xb1\x03\"8\xfc\xd8\x11\xb40\x14\xc8m\xc1\xdb\x8a\x89\x9d\xbeu\x81 .\xdc2\x84S\x9e\xc6\xff{\xa5\xd5\xef\x9d\xb9x\xc1\x80\xd6&=\xbb\xd8y&\x84\xdf\xb1\x8c\xbc\xa49\xc7\xe7\x99!\x0eg\xbf\x80\x8c\xf4\x8fM\xaa\xf0\xc5&\x06\xca\xe8\x18\xc7t\x847\"\x0d\x10\xdcr\x9d\xa4X\xf9V\x15\x99{~\xd7\xc3\x14\xc0\xcf*y\x8f~\x18\xa2\x86\x11\xcc\x05\x0f\xa2\x8eZ{\xb4K\xe1W?\xbcm\x8d\x92p\xf5\x0e|\xf7y\xf3T\xc9+;\xec\x19\x1d\"%Dr#\x05\x18\xdc	\xc6\x0c	\x83\x1e\x08\xfaH\xbf\x9d\xa4Z\xf0\xbc\xa52\xe6C3\x90\x05\xff\xf6 \xa9\xd1!\xfcT\xae\x92\xf9\xd8\xa5\xe9[\xa5\x8c\xa4\x15D\xd5\x98\xdf6`\xf2\xa7\x05\x81cR\xbcE\xf3\x98 \xda\xc5no\xf9tu\xe5\xc7\xdb\x13u2\xe6\x9e\x13\\\xc5\xc6\xa3\xd1=i\x88\xdf\xb9\xa6\xa3\x17\xfc_\xb8'=\xda\xd5\x80Nf\xc1\x85\xc3\xe9\x95\x05M\x83\xa9&##d\x92\xa0Y\xf4l\xbb)\xd6\x87\xe8\x06p\xd3\x0f\x9dps$\x1d\xbd\x01\x07-\xae\x8e\xf2\x92\x91\xbe\xea\x9c\xe8\x04\xa7\xdeC\xe7\x90\xba\xa2\xackH\xfb\xe5\x8e+\xb0S\xdd2VBL\x00\x86:\xcf		\xf2\xeacu\x8a\xc37\x10\xcc\x1c\x10W\x1d\xdc\xd7\x8e\"n[\x8b\xd5\xdb\x88\x1b\x964<j\x1b\xff\xabA`}	\x9c\xbf6\xc4r\xea\x06\xcaQ\xda\xf40ab\xfc(\xb5\xb6ug^\xa3\xc7\xeaG=\xb8\xf2\xe1&M\xd3^\xa4\x9d\x18\xff\xbc\xe3\xdb\xef\xe51\x8ev\xcb\x16\xce\xc7\xc0\xa8y\xed\xd4\xf8]\xf8\x02lN\x85\xad\xcd1\x17\x8e\x94w\xa3\x00\xf9\x9e\x93\x1b0\x1e\xfa.v\x1b3\x85\x16\x0b\x17\xbf\x17`#=\xca\x85\x98\xfbx+\xb8x!\xca	\xaev\x83\x02\x7fj\xf5\x04\xa6V\xde\x11J\x0e\x08\xb9\x18\xd9\x99\xedG\x90\x04\xf4\xa1\x07q\xfd\x92\xf5I\x11\x93y23\x16D\x96\xee\xa9\x0fz\xab\xba\x9fo[N4~\xd8\n\xe8\xcb\xf76+h\xfcQ\x88V\xd7T!\xec\xa1yt$\xde\xdc\xe4 \xcf\xe0\xff\xf5=\xe5\x1a\x82\x7f\x10\x8c\xdf\xab\xaa\xed\x9e\xb9\x82Qe\x87\xfe\x83	c\xeb\xde\xfeE\xfd\xae\xabA1\x83\x03J\xd5\xa5\"r\xa8\x91\x15[\x98N\xc3\xbc\xd8\x86\xe3*\x10[\x17\xfa`Y\xfc\x85p%\xff\xcf)\xe8xv\xa2$\x9d\x10t:\xee/~!\x9d\x1c\xb32h\x1a\xfe\xae\x8a/\xe9\x84\xe8\x1c%\x1e\x87\x0b\xcb#\xcd\xe0\xff:\xa2B\xe8x\\\xa5E\xbd\xe7\x12\x8f\x89S\x1ed\xfd9\x07\\=\x8f\x8dx\xdfU'Kr|\x1a\x9f\x07\x8d}\xd9(\xe8\x1e3u\xe7\x91k\xb7M\xbaaaQ\xd5\xbb\xac\x13\x95\xb5\xb0iN\x9e\xdc#\x04\xfcD\xd1\x94\xfeA\x1e\xad\xea8G{\\\xec4\xa6)go\xabnJ\x12\x13\x93R\xfa\xfdl\xbaP\x85T\xee\xa6}\x0f\xdf:\x89R\xd2\x0b\xf6;\x0f'\xa6\xf2\xf9Tb\xc8\xfe7I\x01\xb8\xda\xf8\xde\x1a\xde\x12{\xd1x]\x94\xc9\xb4\x91`\xe4Z\x00j|\xbe1\xc6T\x11y\x8b\xac.QG1@@R\xd9\xb7\x1au\xa3Ce\xddg\xcf$=\xd75\xfe\xe6\xf1\xcfTBf\x06\xd5\xd0\xaf\xf6 6\xa7SBO\xd8\x9dh\xf2)L\xdf\xbe\xfd\xaa\xe9F\xeb|}#}&\xea\x83\xfb\xd4\xa0\"\xaab\xffB\x99\x98\xe1\x1f\xd1\x84{s-\xa9cw\xe7~FO\xd9Z\x05\x82uJ\x8f\x9b&\xed\xb59q\xf1b\x97^\xaa\x14\x9a\xf6\xb58\xac\xad\xdb\xeb\xb9F\x99I\xc9\xc1\xad\x83\xb7*8\x19\xd5\x10\xb0y\xe5\x8ell\x13iv<\xf5\xf9\xeb\x1f\x14\x90a\x1ayu\xa9\xf5\xa5\xd4\xd0\xf0\xe3A.-l\xa6\xbb\xdc\xadB\xcd\xf0\xf3\x19\xd5\xc8\xefs\x1a\xc3A\x04\xba\x02\x07\xf6\xbb\xb8\x12\xad\xa3\xbf\x84\xd1D~s\xe5\xb1E\x07\xd3\x0b{\xbd\xf4jE<\xa0\xbd\xa0]\xf3(\x02fCc\xc1\x84\xfa\x97\x02\n\x02\xc3e\xa3\xbbW\x9b\x00u\xf8\xef\xa0\xb8\\\xda0\x93\xf9V}\xd8\x0b\xc3\x12\\\xdc\x92\x996\x93G#\xbb\xcc\xa3\xa3\x94\xc9S\x9c\xcd\x82^n\xa8\xb2\xbd<\xb2\x14\xba\x9f\xeb\xaf\x1f\x14\x8bg\xa1\x91\x16T\xb4\xf2\xd6\xcb\x81W\x93\xe7F\xc9!O^N\xdeB\xd3\xab.\x8a\xc8^\xa9\xc3\xe3H\x94\xbbw\xae5#\xf52$\xc9\xc0;Rl{\xb1\xda\x82\xce\x8e\xe5\xc2\x9cvu0\xc6\x96\xc8\xabOG\xd2\xcf\x06\xfd\xb3t6	S\x93[U\xba\"7\x12xa\x8a\xc3\xc5\xfd\xc8\xe8dz\xf7\x0d\xca\xd4\xca7m\xe1\xb2u\xc4\x1a\xa7\x0c\x01\xecP\xf6\x12 8W\xa5~B\x00\x98\xa8\xd4\x0c\xa3\x9flwwa\xaf\"\xeb\xd8\x97\xf1\x91\xb5\xb9\xbdt\xc1\x0d\xa3\x9ds\xc1\xa3\xa6\xc0\xc7&\xe3R \xbd~3\xb7~\xab0\x96\x8a\x7f4T\xd2P\x06|\x9c4\xe6\xc7\n\xdaO\x1e\x8c`3\xab\xb7\x7fw\x19[P\xfdN\xce\x125\x83\xda9\xf7n\x11\xfeb>M\xc5\x15\xaa\xcaJ\xc7\x0b\x0d\x0b1\x965\xc3\xe4#m\xbe$4\x11\xb4\x96P\xd8\x88\xa3L\xa3\x8c!\x96\x97\x9b\x05TU\xf6z\x9b\x81@\x9e\x88j\xc8yq\xe4:\xfc\x99\xb4\xdc\xdfs\xa3\xc4\xac=\x83N\xf1\xf7\x87\x86i\x0f3\xd8DMZX@\x17\xc3\x10sg5\xadt\xa1{\x8d\xb2\x8dN\x05\x1fUI:\xfc\x84jD\xc5{\x15\"z\xb8)C\xbe\x90\x89x;\x96\x84\x85\xcb#\xfd{\xb0\xe4\xa9C\xae\x1fK\xe14\x88\x9a^\x88x\x14\xcd\xecy\xaf\xb7^h\x12\x05\xf1E:\xe3\xef\x83G\xdb\x8fV\xd9\x018\x0c\xea\xc6\xae\xc2A.\xe6\xaeV\xde\x1cC\x0d\x8a\xfe\x9c\xa8\xb0\x99\x00\x15\xd8!\xb8\x8cd\x96J\xe2\xed e\x00\xc8z\x12$.\x1e\xa5\"\x1d\xfd\x1e_\xd9~\xe9\x9b\xfe\xb3Y\x0cw\xdc\x88	\xe1\xe8.\xec\x13\xb2\xac\xbe|\x86\xcb\xc4\x04v\x8e\x92M\xd5\xe7\n\xf5\xba(\x8f\xb4\xa4P\xb7\x93\x9e\x89_\x06\xc7\x0f\xa2[#\xa9\x93\x8b\x8b\xc3\xd4\xd5G\xc1k\x1d\x91~\x0b\xca\xc9\x8bt*b\x15\x14R\x87W^\xe9\xab\xf4p\xa8~Gx\x9f\x17\x16\xaa\xb3x*,f\x8e\xd5R\xde\xc1\xba\xb1k\xa1\xb1G\xc6&@\x8c\x81\x13s\x0c\x06r\xff4\xd1\x13\xbd4F\xd8\xb3v\xbd\x94w\xe3rE\xdd\x15:\xa8\x91\x1e\x8d^EyQ[\xfe\x87\x80Dp\xf0HN\x94N\x07\x04\xfdz\x1b\xf0m\xe7\xb3\xe7\xde\x85Fm\xf0\xc1\x93\xdf\xd3a	5\x0b\x8b\xf2\xfexz!\xe2\xce\x16\xdcWV\x1a\x0e\x81\x98\xb2Y\x1b\x1b#\xea\x8f\xd6?\xe2\x04\x8e\xeeHW\xd7\x826\x07\xca\x06<\x1c\x85\xbco\x10\xc1\xd4\xbf\x95\xe9\x00\x9a\xc0\xeb\xbdV\x95\xa6\xdf\xbd\xc8\xab~\\Yi\xf2\xdexcy6j{\x81\x84?\xc6\x1c\x10@\x16;1\xba\xe1\xbdL\x8e\x99P\xe9\x9e\xf0cUG\xde\xd5\xcb\xe6q\xf3\xbeM\x99\xd2\xea\xea\x94\x15p\x07\xda\x92C\x99b\x1a\xab\x98\xdb\xc2\\\xf8\xcaN<\x0d\xb8\x97\xa6\xccK\xdc\xb4	_\xdcW$P*\x0e\xdcu[w\x80w\x0foH+\xf4\xec\x9a\xaa\xa4!%\xe2\xf5\xed\"\xf9\x08\xcb_F\xbb8t\xa6\x91\x00\xdb\xb8\xc3\x83\x03'\xe0>a\xd5\xfe\x90\xef\x92\xe6\xe4\xcb0\x02\xd9\xb7\xe7\xeb\xa0\x8c\x199R\xa2\xa5\xf4\x0c\x8a\xe7\xa3;yC\x8ck\x99\xb2a\xf4\xe6cZ\xaa\x8b\x1ciG\xabB\x16\x07*\xf4\x0e\xb1\xb5f\x87\xed\xf3\xa4J0\xf4\x05{E\x97\x06\xbb|o\xd8\xc4p\xbaY\x95O\x9fU\xc6\xff\x8f\xf8\x83\xc0\xd7\x18\x11]\xbd\x95R\xcd)\xa4\xb3gQ\xa8<\xab\x10\x8c\xbb	b\xc2pI\x1d\xcb\xe1\xbb\x95\xad/\x87\xa1\xf9\x8e\xf2\x05i-\xab\xd8\x0cf\x1e\xe5\x83#Q\xd7\xc1\xde\xdeH9\x86\xd6H\xa5NC\x1c\xb5/\x05\x926rr\x85\xa6\x9cr,\x89\xc3Y\xec\x9bj\xe9\xa7\x10\xa7#;\xdb;z_\x8f\xc9\xc5\xb3\xf8\xe2\xaa\xeeZ\x9f\xb6\xc2\xf6\xf6\xf2t\x9eXe\x04\x8f\xdc\xad\xa2\x91&\x9cZ\xa12\xff\xfe\x93O\xd5\xb6:y\xb7\xd7\xf4\x0c\x8d,1[\xd3\\B\x1a)}	\x84\xc2/\xaa\x9e\xda\xf8\xc7BX\xf6@|Un\xd1\x1f*\xb9,\xdf\xe2\xd1!+\xb5b\xb8\xa6G\xc1k\xed\xf2(5`^n\xf3|\x99G\xc2\x98\xd1\xf5`\x14\xadD\x04!\x7f|\xfdvi\x03}\xcb|\xdf\xdf\xed\x079JUb\xed\xe1\x0d\x00\xb7q\xc8\x07\xde\x07\x02dH\xac<\xacc2\x86\x98\xed\xb7\x0dB\xc80\x9e\xa3\x9d7\xe1\xec#Z\x84\xc60\x7f\xdc\xc5\xde\xd9\x1a\xfb\xce@\xaa\xf9\xe4\"\xe1\xac\xc0\x8a\x03\xb5\x98\xf6P\x97\xc3\xca^\\\xd8S\xef@J\xec\xca\xa6D\xacb\xa7\xc9\xdf8\x94\xe7A\x17\x15\x958cK\x93\x8b\xe43\x89\xda\xb2\xef\xf4\x04\x8c\x1fx\xfd\xd7\xa4.\xdf\x94I\xb0'#\xa3\xcez\xf2)\xeb\xcfZTFn\xc8\x03\x8b\xed/\x8c\xa7\xf8\xf7a\x17\xdf+6\xa9c\x99\xf4h<[^\xd5\xa7\xb7\xf8\xdeR\xfc\xf7\x82k9\xcd\x11;\x1a\x1d\xd7~\xe2e/G\xb8\x07\xaa\xfc\xf6\xaeC(n{X\xb8\x8f1\xf2\x1c[\xfc\xb4\xad\x10\x86\xb2\x05#\x1c\xf4:\x8e\xd7S}!\xae\x18n\x98\x9b]2\xd0\x03\x7f\x11\xa2\xc9\xecK\x0br\xc7\x0f:\xf0\x03\xb2\xf3\x9f\xb1\x9f\xbf\xba\xa6'\xf94k\xb0\x8c\x8e\xc4\xdf\xec*\xb3\x86K\xdc\x91\xf2SU\xb8\x9d%\xcd\x19\xb4\x8f\xc61\xca\xc8\xeac\xd7\xbc(\xe6!\x90\x97\x80x\xf2\x8e[\x16\x86/b5\xe1B\x92R\xbd\xd998\xce\xb7Y0\xed\xc1H\x0fv\xc7w\xde\xd1B\xcf\x06\xa1\x84_\x0c\xf4\xdcNg\xb4\xc6}_\xc6~\x08\x18\xfd\xd5\xe7fS\xc1\x80C#\x89\x10}:\xbf\x04D\xf4n\x0e	\xf6\x05\xa6\xb1o\x97\xc7_Q\xa3\xde<U\x1bL\xfb\xec\xd6fh\xaa;\x85\xb8\x10p\xebK\x0f\x0e\xc8\x02fv\xb3\xfb\xad\xb8:\xa8\xa41\xe4^3\xe6\xa6\xab~\x8a\xd7\xd7\xb3\x9b\xdf\x12\xd6\x8cA\xaa\xbb\x95\xb1\xa6\xa7\x87\xb9\xff\xdc\x1d\xa0\xfe\x8c\xfa8\x08\xb8\x87mK=a\xce\xe4\xaf\xb2\xbd\xf9\xac\xb2o|\x8eJ\xe6\xec5\xe1\x82\x02\x1b\x0b!\xc6\xff\x8c\xaf\xea4W\xd5\xcc\x83n\x9c\x19\x9c_\xb7\x97Fj\xd3\xaa%\xfc\xcd=\x18\xd5\xf3\x9eO\xe7\xd5Bv\xfd\xa5\x8f\x82\xde\xef\xef\x872\xe6nu\x1a\n%\x91\xeeJ\xa6\x9b\xe1\xc2\xd8v\x86\x0d\xbd\xba dc\xcc\xa2T\"\x8cj\xa2\xeb+F\x88!\xef\x9d\x97\xfe\xdc\x0ex\x8e\xd5\x11\xce\xfa\xf8\xc4cw\xc3\x800\xd9\xd82y\xdf\xa7\x14\xff\xcb\xf0\xe9\xf4\xae\xaaD\xe2x\xb6O\xa4}\xdb\x0e;\xdfb\xc1X\xd0\x08\xc6p\xfem\xae\xc2\x16\xda\xce\xee@\x1btw(\x91\xe6\x10z0>%\xefh\xd5s\x9a\x83<\x1b'\xd6&mi\xc9\xbf\x13\x11\xd1\x98\x91\xf1\xc5y\xefj\xf1\xa7\xd3\xe5\xb6\xe3\x029N\xaa\xdb\xc4\x9f\x01)^p\xbfS\xc7\xa8\xbf\xf5\xd1\xd6F	\xea\x97\xef\xee\xb1\xe5\xfd\x92\xc9\xee\xe2H\\\xa4\x9b\xf71Zx\xb2\x9f\xde\x8c\xb76\xd7\xb3Ao\xa4\xb0\x16\x08)\xa3\xdeJuW\xfbv@\x05\x17\xc5\xa8\xf5b\x05<\xb3\xbb\x93\xa5\x8c\x8d\xa5\x1b\xde*\x95\xc9x18+\xd8*#\xebt\xc2 \xcb\x89\xac\x91\xf3\x86\x9c\xee\x9b\x04\x04\x11\xc3\xd6\xb0Q\xe7\xde[\x18\xe5T\xdc\"\x01:\xc6I,\x17\x85\xbcefQ\xf3\xa3$/\x8bd\x0b\xaa\xf2\xf9\x19\x8f,KR;{\x12'\xc2X\x92\xe4\xec\xfbu\xb3\xa4\xe2\x12\x84\xe7\x1bF$\xc1\xee|\xe1Z\xf3\xb7\x8a|\xd1E\x02\xc3\x86\xed*GP\x89\xf2\xcf+\x14*j\xea\xaf)C\xb1'6OKe\x8a\x7f\xd3\xc2\x05\x02\xd2-\xa5y\xbdz\x92\xf4r\xe9\xbb\xcdlum\xc5H\x0cB\xc7\xa4\x1d\xd0K7S\xc9%f<\x83\x7f|\x1c\xc4\x1bx\x94<!'\xda\x13\xc0Y\x1c\xfb\xbd?\xa2\xec&rL\xc8\x04!3	\xd4\xdb\x1e\x06\xf8\xeam\x18\x1d\x82=\x8f\x95\x86\xceV}\xd8j\xd7!GkZ,\x1d\xf6iT\xd4\x19eW\xed\xb7\xc7qt\xb7\x06\xc8\xa0[\x04|\xda\x0c\x93\xddYQ\xb5\x0f\xeb\xc9Ql\xbbW3b3gDZ\xbc.\xd6b\xf0`_SG\xef\xdd\xbbt=\xf4\xa8\x9e\xc3lt\x95\x81w\xf3\xa0\xe6,\xaa\xc6\xc3\x13\xf6mB\x8dBt\xa6\xb7}\\\x9b\xc1*\xf5SZ\x8d\xab3\xa3\x14\x1f7\xe3\x99'\x0bj\xd2j\xfa\xd6\xdbX(0,::\xba\xa6F\x04\xb3z]\xb5\xf3:\xbd$7\x1d6E\xa5\x1ci\x13\xdf\xf8\xbaq]E\xb7\xf1\x95+\xec\xb1\xe3\x8b\x9f	~\x82\xc8\xcd\xf4\x1bA\xa77^\x7fI\xf1\xee6\x10\xaf \xdb\xb0&\xe0e\xb5\x97T\xde\xbe\xb1\xcd\xb9\x93\x9dk\xdf\xcbb\xc4(~N\xe0xq\xdf\xd1\x08\x01A\\:42I\x14\x9a\xb8\x19\xaa\x98|\xe2_\xd5>\xf8\x8b\x80\xe4\x9b'nO/\x03>'Ltt\xcc\xbeD\xe8\x17\xc2\xbb\xd3\xb5\xae\x8e\xf2\xa3\xeb\xe5\xfb\x8d\x83\xb3&\xac\x87O\x93\x90\xe81(\xe7\xfb\xbe?\xfc*Z\xe3m\xc9\xf7\xf7n\x80D\x80\x03\xcc\x9c\x1b\x15:\x00\x00\x00\xc8I)KV\x8b\x1b\x7f\xff\xff\x02\x00\x00\xff\xffPK\x07\x08\xfb\xd2\x9a\xc8+ \x00\x00\xd2 \x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x10\x00	\x00gopher-fly-1.pngUT\x05\x00\x01\x80Cm8t\xb1eT\x1c\xde\x92\xee\xdd8t H\x82\xbb\x85 M\xe3\xde\xb8\xbb\xbb\xbbvc\xc158\x04\x0d\x92\xe0.\xc1%x\xe3\x0e\xc1\x83{\x90\xe0\xee\xf6\xae\xff\x9c3\xef\xcc\xba\xf7\xdcZk\x7f\xd8U\xf5\xab\xda\xfby\xa2T\x95e\xb0\x80$@\x00\x00\x80%'+\xa9\x0e\x00\x00D\x01\x00\x00\x1b:*\x00\x00\xc8S\xf0\xa3\x04\x000\xe5\xec$$T\xe5$$\xa8T]a\xd6v\x8eV\x00\x80lT\x8a\x0e\x9a\xa6\xc60I\xb6\x81\xba\x9cm0\xba,\x06<J\xbdP\x01#O\n'\x81i\x01]\x8e*\xaf\xef\x1b\x8e\xe4\xd1H\x1d\xb0\xcaH\xb2+\xbc*\xfac+\x81\xe8\\t\xeb\x9cTm[*\xa1\x95\x9a\x9e\x1e\xe1\x1b\x1e\xcd\xb5\xc2\xa0*\xdf5\xdf\xbd\xe7\xbd\xb1\x15\xf0\xeb\xd3\xe53\xbd\xdb\xde8 Wg\xf4\xab\xfc\xa5\x9a?\x00H\x17V\xd7W\xca6\x17z\x85IP\xf6\x8e*\x08\xe0\x1e$bzv\xe7\xca(HMM J\xec\xf7b\xb4\xb6\x12:\xa7Z\xd5R\xd6\xfc\xc2\xffb\xd2/J\x95|\x89 \x8aG5\x8e7t\x8f\x82C\x89\x0c\x00\xef(X\x85\x01p\x9es\xd7<\xa4\xf34\xc3\x18\xc4\x1c\xe9B\x10\xc4Y\xb46\xc9\xb0X\xb56Y\xb0\xaa\xd4\x97\xe9\x18\\\xa9\xd6\xb0\x00\xdf\x07\xfe\x0c\xbb\x02&\x9d\x82}\xb4qE\xd0.\xfe\xae\x85/\x18\xd1\xe0(\x00&i%\xc8\xc6\x08\xcf\x91qd$\x84\xf2sM\xd3\x81\x12\x82\x1b\xc9\xd7\xf8*\xe3\xeb|\xf5\xe1|\xde\xed\x99\xd4\x15\xc3R\xa0m\xe7Z\xc3\xf4\x89\x8c\xfb\xc0\xd4?\x81\nb\x1d\xe6\x93\x11H\x8e\\\x89,\x02j)\x9c\x15\xcb\xc7\xedv\xecL\x8a!\xcd2I\xf8\x84\xd8\xb5\xd4}\xa7}\x88?!}\x1f\xb5\n#\x95\xa7\x16\nv\xfd\xf8\xd1\xe60\xd9\xa8=\x08\xcfz,\xa8x\"\xf8\xd4\x93(	\xcb\x7f\xdbX\xc5\xd0\xb6\xe0\xf4\xe2\x7f&\x88y\xd32\xbc\xd4\x12\x13v\x11I\x07\xb2\xa9t\xd47\x7f\xa2\x1aOuYXj\x89\x15\xfa\xbeT=\xf2\xd2@\x03\x0e\xab\xdc\xe9\x04\xe1\xb9\xa2\xdd\x9b\xa4E&\xcdp\xb1[\xcc\xd2\xb8\xd8\x93\xf5\xb5\xe2p\xeex\xcb\xb1\x93\x90\x8eUI\xf6E\x916$2\xe2i\x9d/\x8b\x91Q%K\x86\xd9\xdd\xd52.\xd6\x0dIV\xc2+D\x85\xbf\x81\x1bu\xe9v\x07\xec\\>\xe5}\xe4\xd7\x1f\xad\xcf\x98\xd0\x18\x84!\xba\xc4\xa8\xf6(\x13o\xe3\x8e0\xf4\xbd\xdd\xa5\xc1	\x97\xb1u\x1d\xacB\xa7g\\\xf2c/j`cQ\x13\xbc-\xe2\x12\x11s\x88\xd7\x08\xbbWc\x82c\xf5\xed^\xe4\xd3\x1d\x1b\n5\xe3\xb0\xcab\x89P0\x80\x14e\xe7\xfa\xa2lv.\x06>\xd8nu\xc7\x88\xb1l\x049w!\x02\x17\xea\xba\xb8\x12\xde\xf4\x1dC~A\xce\xd2\xac\xe9\xf0\xce\x820\xb7\x01\xd4\xce3\xf6\xa86\x85e2t5*\x88\xf4\x1b*\x91\xa1\xa2\xc2\\`E\x08\x13\xb2\x94\xea\xab\x9dl\xf9\xfe\xaah\xa3O\x83,\x84\x16[(\xbc\x19\xd0\x17\xe4wg\x90\x97MO|\x91\n\x0c\x88 9\x17i\xbf4\xab\xb8\xaf)\x1bx)\xb3\x90P\xaa\xd0. \xda\xb6\xda\xc7\xd2\xc7\xa3\x8d\xdc\xec\xb35\xa7\x9b\"\xe6\xed\x0d9\x17\x15\"\xb8\xc9\xab\xf1\xde\nh~5m09\xc2\x88\xc7 \x0f\x95	%E\xa6\xdf\x1c\xd7<\x17_\xe4\xbd\xaf\xe9\xe2\xcb\x0d\xa5\xa0\xf9\xc9o\xe3\x9f\xc5y\x02\xd0\x83\x9f\xb3	\xa1_H@3\xa32\x10x\xfd\xb8\xae\xfa\x06$ra\xb0\xd2\x1d[Z\x1d\xabW\xd0\xed\xba\xeb\xdd\x8f\x03}\xc1hA\x94\"\xcc\x82P\xf5\xcc\x8e\xac\xb2\xc3\xfcCH_\xfe\x8f\xfdy\xfc\xe9\xbetnM\x9d\xf1\xc9\xa1\xa6\x17~\x13-}@\x0e\xfb\xba\xbeyL-\xd2\x82\xde\x11\x9d\x07\x85\xe0gn$\x02\x0f2\x87nV3\x19-/[\xfc\xe7*c\xa9\x905\xbc\xdf\xe7\xdbb\x14\xe7\"O\xe7\x0d\xc2Z)\xc9m\xbc\xcd\x9em\x81\xb3_\xf0\x811Z\x8f\xb4\x1b\x8a_\xc7\x93\xa3\xdc$\xc3XxV\xee\xc3X\xaaBE\\\x91GgV{)\x01\xbf=%\xeb\x95\xe0\xe6?:\xb1\xd1/{\x08Wi\x98\x00bI\xc1\xc1\xc1\xb4&\xee\xec\x94\x00>[,\x80\xd6\xbd\xd4\x9e\x17>\x14\xcd\xb9Z\xa4)\xa9:\x01\xdf\xce\xbd\xf9\x07-\x80i2\x04\x96\xccX\x83\xdc\xd8\x85\xb1\xfb\x07\xc3O\x94\n\x1d\x05\xd7\x94%\x12m\x98\x8a\\1X\x8b\xa0\xfb\x9a\x05\x89\xb3\xcb\xb1\x06\x90\x1c\x0c\xacA\xac@\xf9m\xfan\x01\xe8\xf1\x19b*b\x8b*\xd3%w\xf6\xa6\x19\xbd*\x88t\x83\xd5\x10b\xb4A\xfc\x17\x05&*\xf2\x99\x8a.4\x8e\x0dG\xa3\x0fX+c\x88\xab\xd2\x87\x8d!M\x93\xa0K\x87+'\xc3\x91H&\xf9\xf9c\xc2\xe2\x10M!\xa2^\x98&\xadA\x82\xcf6\xc36\x9b+\x1e\x7f\xbc;\x95gB\x84,\xef\x05\xde}\x90\xf9G,nq\x90*\x9a\xfb\xfb\x83\x88\xba)\".\xe2\xac/\xbb\xb93Sx\x0d\xef\x8d\xc2\xb5\xf3>\xfd\x856`@\xa2\x8c%a\xb9\xf2W\xc4K\x11\x08\x8ah\x16\xe6s\xdfHw#\\j\xbcX\x90F\x07,\x17\x0c+\xc8\xca\xfb\x0ej\xa4+\xf0_&\xe6\xd9\xe8\xe7B\xe0\x83Q|H6\x9bPg\x14\x99?\x8c|\x949q\x13\xfdi[\xbf\xef\x94\xdb1DbMz)\xb7\xe0w6\x88Y\xa6\xca\xce\xf4\xc88\xcd\xa1\x18\xa1d=\x98\xe2\xc4yI5Y\xce\x91\xce\xa8\x1dJF\xa1\xbc\x924\x89r\xeet\x0f=M\xecY\xdf\x142\xa5\x0c\xe5WDW\x14\x84\xc4\x8e\xa8<\xf0\x1ee\xe9\x92\x18\x87S\xdeKF3\x9c\xcb\x86\x15rL\xfbLC\xa7]\xa6\xb5\xd4	\xf7\xc9\xf5\"\x1c\xa5n\xe8d\x928\x87\xf0.\xc4]\xe9\xbd	\xef\x89\xee\x99\xee1Zr\xf2UjK\x90U\xb7\x18\x11G\x14G\\Gx\xe4\x99w8\x8ab\xa6U\xb8s\x05\xd4CJ\x87g\x0d\x0f\x10\xed\xf1\x7f&\xfa\x84\xfb\xc4?\xc8\xcb\xc8\x0cJ\x0f\x9a\xd8\xb4\xc7\xe9\xdat(\xa7\x8c\x0bg\xb9	`\x7fZ\x9b\xc1\xb4c\xe5z\xeb\x84\xa7$f \xbd,&(\xd6&\xe1+U2C\xfe7\xef\n\xd3\x87\xda\x9f\x18\x9e\x1d\x10\xfd\x12\xf4\xa2\x9c\x96'\xa8\x9eV\xce<\x99UbP\xb0\\\xe8\xabu\xdbB!5a\x1c_\xc9+U-Y\xad~\x1a\xb4f\x05\xa7\xf0\x8f\xee\x08\x11\x96\xc9)\x11\xd1\xe9\x94~\xady\x01=\x0b#\x85\x07G\x88\xb5\x8b\x05w\xab\x87\x18\xa1\xeeae\x93$a\x92c\xd2b\xa2\x84\x17\x85\x1b`\xa9\x10{p\xb0\x85\x83Bz78	\xd8\x1b\xd9\xc7\xd9Z\xbfDJ\xc7I\x1b\x16\x18\x16\x00\x0b \xd2\xc7\xd2\xf0\x81\xf0\x81\x82X\x8d\xd8\x98X\x87/\xf1$\xcc\xdf\x89f\x82\x87\xadv9\xd38o9a\x1c\"\x98\xf5D\x03\x14\x1f\xc8\x1a-u\xff\xd4\x94\xf7\xae\xa5c\xf2bp\xe3\xa6'g\x1cq\xb7\x98]t'[\x91\x98\xa5\xdb\xb4N7,^u\x10Cw\xcdy\xd6\x1c\xc3\x17\xa5\xeb9\xad!\xbd\xb1\xe1ho\xf7\xb0\xc1\x94\x1d\x81w\xb0\xdd\xd2\x96\x18\xef\xfc{\x96\xcb\xca\xdd\xb4\xb1G\x16\x16P>K!\xc1!HY\xefJ\x97\x03$\x01r\x03\xcd\xebR\xd4\x1f5\x9a\xeb\xa2\x18DU\xce\xe9\x927=\x84X\x8d\x99\xd7_\xe8iU\xccT\x18;\xb8\x1d\xda:\x84]\x13\x1cz\x1c\x06\x90\xcad\xbc\xcf\x08\xe3\xf1u:YVwzv\xacw\x9a_h\\\xbcY\xd2[\x8c\xad\xcfp\x9a7\xbd\xcd\x80\xf4\xafM\x18[v\x8e\xbfT<\x1b\xa0\xc9DpE\x9c\x92\xa7\x93g\x0b\xa4\xf3\xb9\x92+\x90\x91\x92\x9d\xf2Oe\x89g\x16\x0b\xfe\xe67\xf2\xfc \xf0\xc8\xbf\xc7\x0fs\xee\xd8\xfa\xdc\xea\xd5\x16iho\xc8\xda\x96_%\\e>\x8fUEN\x8bN\xfb$#+=\x1b\xdb\xbc#\xedf\xd1\x86\xdf\xc2\xdd\x0c!\x1b&#\x16t\xdd\x1c\xdaM\x9c\x90Y\x8c\x1d!\xfa\xe9:\xbb\xa8\x96\xb3\n_\xbd\\mjY\xbff\xbb\":\xac\x9d,\xf8\xfd\xa7\xa8$\x86a\xbd~\xe0\xb2\x95p\x95y\xda!['f>f\xaa\xaa \xac\x807\xae\xe3\xf8\xd7\x19\xc5\xde3\xdc3\xe3\x96\x81\xd2\x84\xf2\xbbJ\x81JSu\x16\xac\x18Vy\n\x12\xa1\xedD\xee,\n\xd8{\xc2x1{\xb9|\x8a\xbf\xeb\xc4>\xc3\xf8\x88\xb9@y\xa6\x93k`j\xbcQ\xd5UbA6\x88>\xe8H:Cl\xf9\xae\xef\xcd\x12^!\xa65\x8a\x0d*\xec\xadv\xb8n\xc4\x99\xf8\x818E\xd8R8P\xbc)B\xd8L\xb0\xdem\xb1mq\xa2w\xa5\xd7\xd2,\xa2g\xcd\x15\x91\xcf\x8d\x8f\xa1\xa7\xf6\xac\xf4\xec\xe2\xec\x06\xbd729\xf2 \x12-\x91'\x91?\x11+\x11\xae\xb6\xa6V\x9f\xaf,\xf9.\xdf\"_Gz}K\xdf\xf9~\xc5\x07z\xaa\xbb^\xeb6\x90\x1d\x97\xd7O\xd7\xaf9(\x14\xfd)*\xa5\xbf1Nb0\x96\xbc\xc9g\x05\xce\xf7\xc8'g\xe8&\x14\xb97P\x1d\xd7\x99\xb3\xf1\x8d\x0d\xb2\xb9w\xdew\x8e\xf1\xc7a@D\xf9J63\xcb\x8b'*\x91\x8b\xdbNg:c\xbbf{g\xd8S#S\xfd=\xa3S\x99O\xd9W\xf5C\x8d\xf5\xe2\xb0\x92Jy\x0de\"n\xb3\xf1\xd0\x98}\xeb\xfd\xad\xabTLui\xfb\x81\x9b/\x1dQ\xb8\xef\xe6\x95\x8f\xd2\xb7!e\x8e\xd3\xbf\x86\xe3\xbe\xc60\xe4\xe4\x98\xf3\xd0\x07\x8eL\x95\xf9hxk`[\xe2q\xccpxZ\x05l\xf7_\x1ex\xf9\xeb\xadT,U\x18\x0bGt\xc6\xbd*\xe20\xe9\xd2\xfe%@\xc5\xd0\xc1\xc8\xb1D\xb3\xaa\x1e	H\x7f\x97\\/Pb\xf0\x9e\xe6\xc7\xfb\xebH\xfe-\xfb\xf3\x98\x95\xbf+J-\xb0\xcb\xadK\x8fm\xac\xed\x92\x9dS\x10\x15\xa1%\x01Y*\x91\xfc\x05]I\x89\x9e2s\xf6\x1c\xf5\xa7\x94T\xb9\x94\x19\xa4\xe2\xea\xac5\xe6\x98D\xa74\xda\x8b\xe1\x8bTqZ/\xd3\xceBgu\xbf\x12/\xdd\xf7\x86\x9a\x1aWP\xe9\x83/H\xfd\xa8\xfdI[\n\x9cF\xd6\xac\xdf\xef\x0b\xe9\xea\xb6\x07\xf5\xeeX^*\x1a\x17\xe7\xea	\xc7\x02\xf8\" 6\xa3\x86\x96\xd0\xb9\x95F\xef\xc6K\xf9\xbdO\xbf\xe2\xd3;\xdd\x10>\x89\xad}6!l\x05\x1b\xfe<\xbb\xceo\x90\xaa,\"(\xbaT\x10\xec\x8cy\xa8\xcaSlR\xd4\xf68|\xec:\xe5\x05\x0d\x10\xc6\x106\x81\xcf\xf5\xeb\x02\x86\xf7\\\xc5\xee\xc3\x80\xb7\xd1#\xd1\xf1\n\xf7\n\xe0\xa7\x8ds\x89\xdf\xd8H\xa3ui?\xc1\xb7z\x1d.(.a\x96\xfd\xbfR\xa8\x99=\xab8t\xb5\xea~\xb8]7\xffVr\xd0u\x18\x99o7\x16\xfct|?2\x95\x921\xa76\xcf8\xc7Xn^\xae?\xb7\\iR\xfbb\xf1\xb3\xf4AZI\xf7\xd7\x9a\xfe\xe5\x12\xfdO\x18/:\x8f\xe1*Qk\xad\xcb\xd7\xfe\xe2h%J\x9e\x18\xde\xf55\xbc\x9fEe\xe3yU\xae\xcc.\x0eSs\x83\xcb\xeaMY\xcfS\xa7\x83\x0f\xc7\xd6\xcb\xcc\x8d\x81K\xd4\x8by+\xdf\x9b/\xdd\xe10\xffg\xd6K\xa3Q\xd2IT)\x89\xdb\x13\xb9\xb6\xea#\x8c\xf5\x99\xe9\xbfs\xcdV`7X\xbfp\x8dJ\xedi|\x0eG`k\x8b\x88u\xe02B'R,\xda1\xf6\x1c\x859\x19\x13L<\xdd\x9a\xe7\x9a'&=\x05\xb4\x0f\"\x8f\x18\xa4\x80:`9B\xae.\x87r<\xc9j\x85\xd5\xddf\xef\xfe^X\xccZ\x14\x0d\xa2\xc6\xa5\x91\x9d\nRC\xa6\xdc8\xf6,m\x96g\x1b\xdbJ>d\xb6\x06\xf8<\xed\xdfp\xb2\xdb\x8c?\xe3\xdd\x8f\x96\xd5\x1f\xd5\x0fN\xa0d	\xba\no<m\xbe	 L\x07?\x18:\xb6n\xfb\xefOV\xafuR\xd8\xdf\xda\xc1Z\x8f|[v\xa2_S\xe6\xe2\xd8\xc9\x07\xab\xc2\x0d!\xde\xa0\xa7%\xf0|\xcb<x-\xb2m\xca\x85sg\xf7\xd8\xe0+\x94\x11\xf6\x06*\x02\xff\x1a\xb8\xd7\x0f\x93\xea\xbc^\xcf\n\x8cw\xae~\xeaxy\x1eA\xdb\xc5>x\xd9y\xaa\x86\xcbS\xeeLElb\x83!\xf2>\xeeM_\xd7\xef\x8f<\xefO)\xdb\xe4)g(u\x84k}\xd4_g/\xf7\x13O\x86N\x98\x03\x15\x9f\n\x0e\xbf_\xe9\x05\xaa,\xe6\xa0\x06\xce	{\xfa\x04\x9cUOv\xb2^+\xff=\xf6\xa1\xf6e?s\xddg\x9e\xd1\x114\x8e\x0fX\x9dK\x9b\xbc\xddP\xea\xcexi\xda9U\\?\xf6;\xbd}9\x8ah\xc0Vy\xb0\xf1\xa3\xf3\xafx\xd8~*\xbbv\x1b\xdb\xf3EBABR\xcf\xfeR\"\x0c4\xad\xc9[\\\x04\xb4\xaf \x00 \xe4\x80\x01|\xaf\x80o\xa5-{e(S+J\xbf\xd1\x9f\x18*Z\xde\xfc\xe8G\x0b\x92\xbeS%\x9f\xe7g\x00,\xa0e\xf4\xfa\x0dae\x1c\x94f\x93\x01U\xc1\xd5y\xc5\xf8\xde\x8di%\xe11\xa80\xa1PO$\xa4j\x16\x00\xd5O\xc439\x1e\xb6\xc9N3\xd3\x8d\x19\x1e\xa2#r\x80\xb2\xe2\xfc\nXK<\xd43\xe4W\x1e\x93{\xaf$\x1b\xa5\x1f\xed+*uf`\xcb\x86\xf6\xfd;\x00\x00\x80\xe1,\xab\xe7\x06\x00\x10\xd0\xffs\x10\xe4\xd4\xe9\xce\x00\x00DT;M]w]%E\x01\x0b\x98\x13\xab\x99%\xcc\xdc\x8a\xd5\xcb\xc9\x19\xf0O\x08y	x99;Y\xb9\x9bQy99B\xdd\x04\xbc 4\xff\xd5!\x00u\x13\xf8'\x0d\xa6\xa1\xfa\xaf\x16w\x07\x08\x8d\xae\x92*\x95\x04\xcc\xd5\x8a\x8a\x9b\x95\x8b\x95\x8dF\x18HEE%\xe4ji-\xa0.)\xfdo\xdc\xd5\xd2\x1aBc\xeb\xee\xee,\x00\x06{zz\xb2zr\xb2\xc2\\m\xc0\xec\xfc\xfc\xfc`6\x0e0\x07\x07\xc8\xd5\xd2\x1a\xe4\xe6\x0du7\xf3\x02A\xddh\xff5\xe4\xbf\xe7HZ\xb9Y\xb8\xda9\xbb\xdb\xc1\xa0T\xff\xdc\xcd\xcca\x9f\xdc!44\xff\xee\xf9W\xfck\x91\x95\x97\xdd\xffl\x82\xba\xfd\xfb[\x160'\xf0?\x150;+\x1b\xf8?a\xeev\xd6\xff\x0f\xec\x9f\xca\xbf0\xe1\xff\xe1\x84\xfe\x19&\xa0j\xe7e\xe5\xa8'i\xe7d\x05u\xb3\x83A\x859\xd888\x84\xc0\xff\xb1\xf4\x1fQ\xdd\xff\x85\xf2\xf0\xb3\xfdoT\xf7?\xa2\xff\xbcE@\xc5\xd5\xce\n\xean\xf6\x8f\x18\xc2\xecB\xe0\xff+\xf7\xdf\xc2\x81\xff\x0f\xe5\xfee\x0b\xf8\xdf\xbe\x08\x03\x85\xc0\xff\xbf\xc7\xc2@\xc1O\\U\x00\x00^\x8f\x9c\xa4\x98\xa6-\xca\xaa>\x86{\x8aTj\x07\xecWvB\xa8#\x0b52\x92\x19\x86e\xadX\xae\x1cM\xa2v\xbcySPP>'\xedA\xd44\x15(\xf7X\x9aW\x80k	x\xa0\xc8\xcc\xc4\xd4\xf8\x05\xc2Z\x8a\xce\x04:\xee\xde\xe0\xf8\\\x07\x16\xbb\xc1\x91}\x87\x87\x87'\xde\xd8\xf4r\xe9\x7f\xfd\x02\x04s)L\xe2>\x8cA\xf6:~\xbcV\xff0\xf0,7h:\x08\xa4!\xa4\x9cf\xdb\xf1\x1f\x1e\x1e\x8eqss[\x97\xf4\xa7\xa8A/\xcd\xad\xa9\x99\x8c\x8b\xee\xf7{\nT\xd3\xdd\x1d\xfbn\xc1M\xff\xbd\xd6\xb4\x1b\xd1\xe2\xf5\xe5Y\xda\xc2\xa2`jz\xda\\SG\x87\xb4\\\xbb\x92c \x96\xb4	\xc3_\x1aE\xaeb\x10\x19\xdez\xa5\xc5\x0c\xb2$\n\xc7*\xad\x08\x9d\xd6WW7\xe7\x05\xd9 \xc5\x94\x18\xc4\x13\xd0c\xea\xb6{\xb1\xb72\xdb\x0eT/K\x12\xca\xc7O\xecq\xae\x05<:!=g\xab\xc2\x91D\noU\x90\xe2\xe2\x9c\x11\x1d4LA\\\x11\x7f}n\xdaN;\x14\xf6\xe1\xaci\xa3*\xc1\xdc>p\xe3\x8e\x12\x1a\xbf\x0c\x1eX>\xde\xda\x86\xe8\xbb\x17F!\xb17b\x87q\xc7\xef\xe7\xed\\\\\\\xce\xe79m\xc6W\x9c\x1f\x8f\x9b\x02\xe3<\xc1\x88\x0c\x80?\xc1$\x99\xa55\x9d(\x9f\xed\xa8S\xc9E\x022{x2\xc4\xeb\xab\x82F\xce\x1e\x9f\x85\x85\x9a\x1ba\xeb\xca\xf4,t\xd4n\xf7\x99'Z\x8e\xad\xb6\xb9\xabl\x81=\xa1\x18E\xeb~\x97\xb1\xfa\x9d\x1f\xd6\x7f\xd8\xda\xda\xb2\xf1@Wl\x85\xce\xfb\xde\x0dv\xfd\x0eD\xf4Ev\x91\xd9\xb1#\xa9\xe5]\xf3\xfa\xf3R\\\xfc!\xce\xa1\x08\x8f\xca\x9d\x94q.\x0f\xbd\xc3\xd9BO\x0d\xe36\xdd\xbdtL\x01q\xe9\xa2\xd3\xe7\xe5~\x8f\xbb\x80\x82C\xe5CH\xc2\x84\x1fLSK\x8b\xf8r\xf7\x97\x03BS'\xf6\xd6\xb7Q\x99\x19\xf9\x91\x89	0\xc4\xe7\xeac\xdb\xcc1\xbc\xa1\x03U\x83\xda^I\xe8\xf1\xa8^S\xbcJN&\x1e\x9b\xe6qF\xfb\xe7\xb2\x80\xf5\xd875(\xc7\x82\xf8\x9b\xef'?*l\xf4\x95\x0c\xe2\xe4sU\x07\xc6\xb3\x04c\xf3\xf3\xf3+M]\x00\x8b\\!\x85\x8d\x96\xe8\xc6 \x836\x8fJ\xb99g\x14\xdf\x902\x9c\xb4\xe5c\x9e\xaa\xd6\x03\xa6\x93\xb7$\xe3M\xefK\x0b\xdf\xf63)\x9d\x19\x16i\x8b.\xf4b\x8b\x18:\xb9\x1eh;\xdc&\xcf\xdb#h\x96\xa5\x04\xa3\xfdL5\x82\xa6\xb1{\x9e\x18\xea\x9f\x9b\xf0\xe1\xaei\x00\x80\xd5f\x02\xa9\xb1\x13\x86xW\x1f\xab'H\xcfP9\xa5\x1b\xa0\xab\xad)\x7fl\xd2\x91\x1a\x10\xaf\xef6\xd4\xc1\x9c\x1d\x829\xf9\x9f\x07\xb3\xea\xabVO\xaa\x8a\x14\xd2\x98\xa8\xcf\x0b\x1aA\x1c\xa5\x101^\xe4\xb4b\xc0\x08i*\xd8D\x99\xfa\x05\x87\xeb+\x92?\xf7W\xff\xd6\xdb\xbf\xc6\x17\xbfuL\xf675C\xd1Ff\x15\xc6D\xa9\x1c\xfb\x16\xe8\xdc\xdaw\x19#3m-\xb0\xfaG\xcb?\x93\x06\xb8\xaf~\xe9)\xe4t\x99\xab\xd0\x1b\x1c\x1d\xbd\xb6Wu\xc8F|\x18/\xc6\x9cIiaiw\xf7\x9c4\x0d\x9a\x15\x8dA1p\xbfh\xb0\x9ba\x1a\x1d\x19\x99\x84C455\x9d\x8fr\xc6\x825\x01)\x83\xe7}\xceTf\xb3}Mk<\x1f\xe0\xb7\xc2\x8fq[o\xdc\x16k\x1f\n\xc4\xdat\xc8>\xfe\xca\x82\xf8z\xf4?\\\x1f\xc6\xcei\x0f\x08\xa3\xbc\xe7\xd8\xe8	&\xc2%c0U\x14\xc1\xdf\xc9\xbfPWW_\x13\x8c%\xfd\xa0\xb5\xf49\xd1\xd6\xd7~3\xe6\x0ds\xde\xac\xcey\x80\x80\xb3\xbb\x86\xc6\xf3\x0e??\xff\xbdm=u\xdad{\x03\xed\xcci\xf1\xb9\x81\xf5\xf1\x9a\xc5\xd8\xd2\xcf\x0c\xa0\xea\xb0\xe6\xda(\xf85\xa7\x1fI\x99@\x91\x058\xf1.\x02\x8b\xb4\xf1\xe8\xbbMv\x99A\x134K\xcd\xc4\x04\xdbx9H\xad\x1dD\xd5\x08\xe7\xfe\x82M\xe9\x7f5\x15\xc1\x96\x1aMg\xdb\xad\x87}\x13N\xd4\xc1\x87\xe6lHhJ\xc1F\xcawos\xab\xc8\xfe\x10\xd5\x1c\x81\xbf\xeet\x95#t\xcf\xda\xfe\xd5A\xa7\xca\xb2\x9d\xbf\xa0gAy\xf2\x90\xed\xcc\xfd\xc5\xc4\xc0\xd88\x1b\xdf\xb7\x83\xa1\xd4\xc1f\xd6.\xab\xbcD%G\x98,\xf5\x00\xe2\xbc!*co\xff\xa3op\x90\xf3\xac\x85\x85`\xd4\xc0\xf6\xf5\xdd\xc8\x0c\xad@%>fc\xc6\xc3m\x13\x08\x04\xfa\x94\x96\x9a*\xf0x{zjM+\xa0\xd7eK\xf0\x03\xc5(\x02\x0b,K\xc9N\xc3\\%\xa3?\x1fy\xd7\x1cy\xd6\xecU\x06\x12\xa4\xaa\xfdV\xadQ\xa2\xbb\x9e\x1e\x06^\xe0\x01y\xff\xf1\xbfz\x86\xd4\xbc\x97\xcd=\x1f\xe2L\xe9\xa6\xdf\xe6\xcd\x01\xeb\"\x10\x95tE_\xf7s\x06!\xe4\xf9=\xd0\xcb\xeb\x17c\xb3\xa1y\xf5'3jpD\x97\x10\x88p\x94\xaeS\xd1\xf3%\x9bI\xa0\xda\x195\xd8&\x12\x1e\x93\xd8\"\xbf\xc1'\xe5\xf6i\xa8\xb5\x1e\xcf\xdc\xe0\xd1\xf76\x9csjR\x1ac!\xe1\xe6r\xfa\xab\xba?\x0b\xcf\xe0\xeb\x15_6D{Gb\x1a\x14\xdd\x90\x0b\xe5o\xf8\xd4\xb6\xbe\xeb\xac\x1b\x7f\xb4\xc0\nS\"ze][G\x13B,\xe4m\x0e:\xde\x10\x87\x04\x85\x94I)\x98\xc9E%\xbf'x\xc8\x0d\x9a\xa9\\\x80~\xa6<\xeb\xe2c\xc3\xe3\xdb\\\xba\x7fSu\xea\xb9\xfai*\x8a\x18\xde\x0f\xadW\xb5\xe5U\xb49'6\xe7\xf6\x0b`\xc6\xc3\xe5n\xe9\x8f&\xf8\xbc\x0e\xe6)\xc9\xfap\x13\xc4\xe8+a\x04\xb7\xfa\xfd'>\xcb\xf0q\xc5\xcdb\xea\x81\xb8\x864\x81\xf1Q\x8c\n\x1e\x9a\xfd\xdb\xacF\xc18[j\xa9M\x04M\x83z\xea\x83tna\xf10.$\x84Uq^\x10\xc3\x11\xb51}B\x93\xe41\x14Q\x10a&\xa8\x05\x03\xa9\xfeM\xdd\xc0\xca\xdd\xe5(\x10\x98\xd4\xb6\xb3-\xef\xa5\xf8>\xca\xd7\xd6,g\x1e\x16\xd1\xa5\xdd\xcf\xb1\x10\xbe\xc5.\x19\xe2\n\xb86%RM\xa4\x9c`\xfb2c\xe6f\xe6\xf8\xf7\xa0d\xafM\xd8\x7f\xe3E\xa7\xda\xff\xe1{\x14\xff\xbb\x81\xc1S\xfd\xeb2?\xe7\xf9\xac\xabi\x90!\xf6\x1e\xd2x_\x00\x05\xfaD\xc7{\x0f\xbe\xb3=\xff\xc1\xb8_\xcaG_\xbfS\xa4$L\xf2\xd3	\x08\x8c\xe0\x12\xec6,\x1d\xb3Eb\x0d\x9cE\x95\x14I=\x19\xe7,\x1fz\xb4\x08\x0dy\x18\xfeRRZ\xdbM\xd5\xa6,\xac]$oL(-\x1d:kn\xbeS^m\x95r\xef`\x91P\x95\xb7\x95$\n\xbbQ\xb3%\x8b5uB\x87F;\xd4\xaa\xcd\xdb|\xd6\x18\xa9q\xa6.\x85\x86\xf7\xef\xdc\x84\x0bdz\xc06\xfd9\xc5I\xe2\xc9\x1f?\xad\x15f\xd0\xb3\xd3\xeb\xcd%\x9fl'}d(\xd2\xa0\x9d\x90L+,\x9d\x0e\xca\xb8\x95L#\x96\x8a\x87\x08\x17\xeb\x04\x88\xa5\x15v\xf8\xa3\x8c\xc5\xf4\x9a9\xd0\xe6GMQl\x07\xeb\x17\x90N\xa2,\x0c\xdc\";\x81W)\x8b(\xff\x16\xf9\xfa>\x87c\xc9\xd7O\xf78U\x91\x13\xdd\x84\x04\xd4R*D\xce@g\xb5(\xae\xfd.G\x98\x95=\xdd.k\xab_\xee\xb6mq\x1e	l\xe7\x852\xa3\xf8\xac\xa8^Ce\xaa\x03.\xe2;)\x9f\xaa_\xad\xe3\xe5\x94\x0bO9\xa2\x93\xcc\xf0\xf3\xf2h~R\x9c[\xb2o\x04xT\xf4\xa6G\xd3^\\_\xe0s\x98\x8f\xc5J\x8b\xcb'm\x8a\xc1b~E\xe7.\x16\xa3\xfd%\xf09'%\xd8\xc6\xd0F\x96\x1drl\xf5\x10\xbc\xdd\xbd\x12y[\x1a\xcaV\xb2Z\x7f\x0c\xcb\xe1'0y\x8e\xf1\x15=\xa6\xcf\x05F\xdb\\2\x82\x84\xf0\xaf\xce\xe1\x19<\x84{q\xa4\x0eX\xf1_\xce\xee\x9e\xcc\xbd\xcc\xa8\xc9\xf4\x8f\xf9\xe04Q\xb8)C\xb9\xb4\x88I(\xc6\xf6?\xb9Z\xfcS\xd3	w\x0d(b*\x1b\xfc.\xf7\xe7	\xd67\xfc\x1c,Kg\xa2\x85(?\xe7\x8b\x0c\"\xe4!\x0c 0\xb3`M\xec\\|Hq[D\x86\x07\xa2\x07\x15\"<\x9c\xdb\xed\xcfYXYEi-7v\x009F\x9a-7\xdc\x83b\x89\xe4\xde\xfb]\xa9\xdeo\x1e\xb2\x8e\x0eC\xfb\xeb\xae\xdb \xc2\xdbg\x01\xac\x17\x80\xde\x0f3\xe9.\xc8\x8c.\xfa\xa0\xba\xc0SaF\xd7\xd9\xa1 \xf6wV\xea\xad\x1a\x8d\xe6\x83\xa28h\x84\xc1\xbfW\xc6\xa7E\xc2\xba>\\\xb6\x9a\x1a\x9e\xb5\xc2y\"~mMt\xd8=\x99\xb6\x83\xb9\x963m\xd4\x83V\x0d/\xb1W\xdb\\f>1\x96\xad\xeag\x18RH;\xe8\x03\xfb[@}s\x03\xe9$r\xc7\xe9,\xea:\xe0\x10\xd6\x89\xafcX\xf4\xea\xcbGxm\xbdo\xc9\xff\xca\x9a\xd69'\x02\x99\xc89\x1fg\xc2\x8d\xbb\x89\x07\xcb\xed./\x96\\\x9d\x7f\xa1\x1a\xe5\xb0\x97/\x97\xb6\x04\xde\x19\xd9C\xe3\x1a\xee\x9e\x94\x99\x0bNQ\xf4\x81qAl'i5C\x1f[\xea\xea\xa9}=\x8e	\x18\xd6\xcc\xe0\x87-\x01\x9a\xc3F\xe1\x0e\x19\xc6R\x96\xf0\xe3{\xca\x8fhB\xd8\xf7!\x92\x1eqi\x9b\x9c\xd6\x04\xb91)\x06\x0f\xfb\x14\xb9\x16=\x90\xb4u\x1f\x83\x8f$\x10E>\xc4\x1e\x0bw\xb4nb|\xfa\xebX\x10\x91\xb5X\xe1\x95\x82\xf6)\x97\xddb\xa3\x94\x1dA\x0cC\x97;\x9a]\xd6Z\xac<}\xac\xb3\x97\xc6\xc7\xcf\x95\x0b\xdd\xf4	\xd7\xce\xb9X\xc6#\xc2\xdf\xe8l?\xee\xf6\x11\xf5\x91C\x93\xa6\xf1\xf7\x05\xa2\x85\x8fp2\xd1jb\xa3bv\xa2\xcbf\xa0\x91\xc7SF\xed\x9a\x99\xae\xbexH\x89\x97\x08\x1f6g]00\\\x9b\xdf6\x99\xa3\xd3\xa3\x7fKJ\xce\xa4{\xe6u\xfa\xbaT\xac\xbdO\x86\xcb\x14I/\xcf\xbfG;\xdfA\xae\x1dnj\x86\xd3\x8e\xfb\x87\x17\xb6\xf8!\xb6\xedV\xb6\xa4X\xba_\xcf\xdf\xab9\x94:\xed\xaf\xa0m\x82;\xd0E6&\x1d\xe9}\xa9\xf3\xbb\\\"\xe6\xfc\x06l\x03\xbe\x9d]\xa5\xdf\xb7\xdd\xc0\xdc\xeb\xe1@\xeb\x83\x04\xea\xef~]\xd9c\xfe\x18?M\x9d\xb6\xceYDc|E\xa9\xa9\xd9\xc5<\x1e\xf2\x0b\x7f\xd6\xaaUP\x9bm}\x89L\x10\xa3\xfeu1O\x9b\x92\x949\xa5A\xbf]\xb2 \xf0\xa6\xd6\xa3\x1aK_E\xcf\x7f\xb0\n2}\xf5\xa2\xdeB\xcf\xa9Q\xc7:\x91\xf2mA\xb7((\xfc\x1d\x83\xa5\x8d]Y{\x08\xcbi\x178\x90\x91\x1f\xa9\xbe\xf0\x9a\x12\xff\x97\xdd3;\xa3\xd1G\x8a\xb7\xf1\xc7\x90\xa0\x89\xd0\xb1\xd0/k\x9a_L\xec*\x90\x82Ub{\xb0\xf1\x83\x14p\xa20>\x0d\xdd4\x04\xb8\x16\xe6\x8b\xfb\xdc\x1c\x97 \x9a\x9cn\x13y\x97W(>\xa9\x02\x8c\x8e\xc5\xbb\xe2\xe1i'p\xe8\xaag\xd2\xf3\"\xd3w\x8eO?$\xc5\xcfL#OV\xf4Z\xaf\x0f\xe8\xefieF\x1c\xc0\xac\xc9\x13\x0e\x0eE\x1b#\xe2v\xe1x\x15N\xc6\x15,\x8e\xa1\x1e\xd1\x89}++\xc6\xbf3x\xb2\xb6\x8a\x9c\xc7\xc9\x9cvg\x87B\xc7g\x99\xb0\x05\xba\xdd\xdc\x9b\x95\xaf\xa5\xdf\x8e$:\xb5\x8d\x88\x0e\xe2lcN\xd3\xd3\xab\x99\xbf=\xc17&\xb4 <v&S\x85bov\xae{\x8a\xd6\xbd\xdc\x94	\xb1\xf96\xe2Q\x10~`\xfa\xeb\xa2C]G\xb9\x1e&-=R}\x1a(Z\xb5Rc\xdc\xe8\x08\x0e\x9b\xa7\x02\xd9\xd4\x14J\x16\xf3\xc5M\xaa\xca\x0d\x93\x0c}\xb5\xce@<r\xe0\xa1\x98\"\xc4s\x89\xab\xe9\x02\xbd\xab\xe7\xf4h\xc5Q5\x06h\x15\x1b\xcd)\xcc5H*X\x18\x91?\x8dQ\xab\xb9d\x15\xf9\xae\xe2\xda\x89\x92\xa9\x06\xff\x90\x90L\xd4\xea\xf1\xf9\xa5\xab\xcdM\xc9\xfa\xb5-&\xe6\xf7\xc7\xeaAZ\x8f6\xa3fA\x17a\x9a\xca1OGZEi\x93S\x19\xe6\xed\xd0\xe5\x18w#X\xa7=\xb3%	\xed\x14F\xd4\x81z\xf8\x9a,5\xfcb\x8a&{\x0e\x1d\x8a\x17\x8eY,c;\x88\xce\x95}K8\xe6\x85s\xb7\xd5\x9bY\xc7P-\xda\xb1\xde*u\xd4\xa1\x8d\x81];\x02\xb8]/j,&P{j\xd7\xd4D\xab\xf2\xa5\x03\xd7\x05\xae\x0b\x13\x874\xe3e$\x17\xa6~[\xc5\xf5\xe3\xc7\xcf-\xba|\xd3\xe8B\x1c.#3\x1a:&\xcc\xc4\xea\xfc\xa8\xb5\\\xc4\x0f\xa2\x94\xc8\xdf`j\x0e\xcbG\xfch\x16\xb8\x11\"j\xac\xbf\xf6\x05		\x08\x97pO0\x88c\x0bF\x8eB\n\xc7L\xc5\xff\xb9\xe9\xda\x95J\x95\"\xf7\xdc\x9f\x9e\xc8\\\xa9%\x83\x94\xbe\xf3\xe2o\x8c~U&qD\x14\x94\xeb\xc9\x93{\xe6\xd3\xb2U^\xbf0\xa6aq\xfa\x05\xd3\x85\x9c)\xe2\x13\xd2\xb9\xdc~\xb0\x8c\xa0\xd0\xb2\xb8\x847A\x10,\xf2i\xab%\xe2\xd4\x90\xfbf	7\x89h]N^;\x90\x1a6\xc7{\x978\xa8\x874\x1eJA\x88\xee.CX\x17\x87\x8c\x9c\xb1\xcb\xd8\x99\"\x12\xb1\x19\x19\xd3ez\xaf\x05\x1e\xbe\xf2Ej\"!w\x0d\xe9j\xb4\x87x\xab\xe7\xfb\xde\xf0\xd1\x12\xea{U\xbd\xa6\x19\xfb\xc89\xd7\xb3T\xc3o\xd2\x15\xb3\x85\xf4\xf27Q\xcc\xd8\x8a\xd5\x01\xd2n13\xbb\x96\x1c\x132/\xc9\xe4\x95e\x82\xb3\xb3\xa1\xf5\x82\xe9\xb4\x99<\xe7\\\x995\xea\xb7\xadn\xcb\xf6]mq\x88\x83[\x8fQ\x92\x1b~\x94X#\xb8\xba\x04f\xca\x11\x0by\x98\xbbh\xdd\xb3\x13R\x1aS\xc17\xce\x11\xce\x03P\x81\xfa>4-z\xa1\xaa\xb9\xed8\xc1\x93\x84\xe8\x11y\x17\xbe\xac\xe9\xbe>F[::mh\x98nw\xe6\xe0h#\\\x85\xd5G\x84\xf92\xbd\xed\xf4-\xe9e\xdb=mt\x8e1\x85z\xa0\x90\xb3\xa6}\xdb\x89@Q4\x95\xfe^\xe54\xa8%L\xad6`\x1c\xd3:A\xcf\xdcpp\xbf\xb6\x04\xabM]kG\xcd\xad\xa3\xabn\xf2\xab\xc3||\x14\x86!\xdd\x9f\x1emn\x97(\x1d\x1d\xad\xa87i\xc5\xeen\xe4\xb4\x0b\xb2o\xf5\x95g/S\x7f\xa4\x007\xaa~\x03\xfc\xf4<\x9cZ(w\xb2\xb0\xf0\xf1\x19\xee\xf1\xca\xc3\xfc\x03\xc9J\xf1m\x92L!w\xf7~\xc9Si[\x1e\xbdY\x1b\xaf]=\xc8k<(ozw\x06\xf6\xae\xae\xdc\xf5n\x8f\x05\xbe\x0bz\xb2\xd6\xf5\x81\xf0B\xdc\xfd~\xb5~\x9aF\"\xe4\xd5\xcc\xd7&=h6\xbe\x94;\x0e\xaec\x92\xc2\x16Y\xa1#/\xb8\\\xea\xa8\x99%[h\xfd\xe4\xc6	\xa3pzj\xa8\x8e\xa8{\xecG\xd1\x9f\x8f\x8dI\x9e-D\xbe\x7f\x9f\xbar~\xdc\xc4\xb4\xb0\xa9\xa1)(\xdd@\x88I,$I\xe1\xbd\x8d;,-\xd0\x1e8\xf8V\x0f8\xe7[R$_\xde\xea\x90\xff\x17=\x95h\xdf\xde6\xa1\xe0\x14d\xa4Bs\x05\xc8\xdf+\x88=\x98N\x1a\xeeVZ&\x80g:\xe3\xec\x7f\x18G$\x07j\x11]~E\xc0\x12\xe5\x1d\xa3\x14T\xa60\xaa\x15\x7f\xe9\x08\x14\x99\x8e\xee\xe2\x19\"\xea%\xcaM\xf8D\x95GB\xfc\xf7\x88\xfe\x95\xbe\x97a\xf5G\xf6\xbd13F\x8f\xfe\x8f\x1bJ\x1cK\x06t\xe9\x12\x02Zl\xd4\xf8\x89\xb6\xd39\xb5{\x1dK\x19k\xba\xfe\xc8\x12\x05\xd8\x84	\xc7Em\xd9\xe7\x8f\x18\xcc\xf3;\xc8\xb3\x9b\xfd(@\xa2\xcf_\xaa\x1aOB\x1d\x9f\xe3\xf7\xb6\x13\x9fp9\xbc\xfa\xd0k\xb3\xb3\xb1M_\xd71\xc1\xdf\x9b\\\x8b\nU2X\xe5\xce\xc8t\xca\x0d\xe8\x15\xb7\xbf\x95\xc8\xe6\xac\x1e\x94\xfbYD\xfd\xba\xb9\xb7\xbf\xadWH\xbd*\xe2\xea%\x14\xe2\xbb?d\xfc*\xad`\xabXHHx\xb6P\"\x94\x97\xbdK\xe0M/4j2\x03Z%A \xed\"]j\xf0r\xe9\xc7\x1e\x1b\xb8\x0cD\xf7p\xca\xbe\xe8\xb8;\x94\x1f^j\x93	\xbf\xfeK-w\xee\xc2\x16%\x15G\xfd\xc7P\xc7\x98\x95\xb4\xb5D\xfe\xe5\xd3\xbc\x82&Q\x93\xb7\x1b\x0bp[\xf3\x00\xd5}98\xc4\xf5\xda\xf3p\xe5\x1e\x05e\x9c\xd5\xa8u\xcc2\xe8\xaa\xa1u\x05\x14\xc1\\\xde\xfb\x85\xf6\xf7\xcd\xc3\xd7\xd8\x9b\xd5\x88\x1f~9`\x9f\x1f\xad\xde\xbb\xe5\x8c!\xa7\x8a\xb7\xbe\x10\xd4d\x19##\x12\xec\\\"\xcc\x00!i\xc1Zz\xe3\xc0^\xdb\xb2\x81F\xf8\xd3$\xb5\x9d\xb0Z\xf5\x02pT1\xf1\xa4\xa0*uJ\x0dw\x0b5C\x08\xe3\x04\xe2%K\x93\xe09\xeb\xb8Be\x87\x126\xfd\xd3!\xdaQ\xb7\xd60\x1b\xbec\x1d%\xa7H\xb7\xe8~<1\xe4\xbb\xedC5\xd3kOu\x9b\xf4\xa3\xfb\xf6\xd7\n\x17\xf4\xe5){\xa1{E8~\x82\xb8\x99Tt\x94\x1ey\x00\x19Z\xd2|0Wf\x9f\xa1GDb``\x88\x07<\x0c\xecl\x95\xbc\xd0\xe9d!\xfd\x01\xbf8w8U\xe8\xee\x14\xf9\x10\xf1&z{<Z\xc2\xf5~\xa3{\xcd[E\xd8n\xb0\x04\x8e]\n@\xed\xaew\xf2\x9c\x8f\x0b\xbc\x043W\x7fs\x7fSu\xe0B\x1e\x0d\xf3\x15\xf8f(N^Z\xa6\xa5\x8c0\xb5\xff\x82\xcc\x14\x8e\xea\xba\xe1Qzb\xf9\xb5]x\x87b\xf19\xc3a\xdb\xab\xdc\xe4\xe4\xdb\xc5\xb7Y\xa7e\x068\x0c\xae\x94\xda\xde\xa1{\xc2\xc4z\xd3\xd9\xe1U\xcb\xfd\xc6\x13u\xe5d\x7f^\xdbR\xf5f\xca\xa8u!\xff\xe1\xaa\x96B\xc8+A\xb3E\xc8#-\xe0,\x18\xcc\xddY\xf3\xb1EG\xc5\xd0\xfc\x0f\x90Rg\x1932M\x1f73g\xb1\x82\xa9\xbb\xf4B\xf9\xe3\xe6\xc6\xd2O\x9a\xd3vz\xff\ne\xfe\x80\x06C\xa0\xf4\xdb\x14\x0e\x96\x14p:\xcd\xce\x1f\xcf\xf5\xe4\xb3H\xf4\xc0\xdb\x93U\x14J[PUBj\x89\xad\xff\xe7\x9c\x9cvB\x84\xf9\n=	\x15s\xe8F\xd6\xbb\xbb\x98\xc3\x15\x9d\xe8\xaa\xcc\xfa\x80}_\xcdT\xf2\xaa\xbd\xc6\xc5J\n]\xc0+\xd69J\x9d\xa0b\x85\x070\x15\xa8\x9b\xfe\xe9ZY\xe4\xd0\xa3~\x99?\xe6m\x1c\x82\xbf~\x8e\xcc\x8a\xf4\xd1\xe9\x0e\xb6\xb0)\xa6\xb2\xfc\x05\xe6\xc8\xb0kg]\xc1\x00\xfc\xa8s\xfd\xbc\xd5\x9fg} I\xc5\xaf\xd6\xc5E1p\xb5\xb6\xb66\xf9\xb1\xec]\x84\xf2\x8b\xf6`P}\xad\xf9\xf7\x10\xc1`\xfe\xe0/\xcec	e3\x04\x04\x8a\xafJ\xea=V\xfb\xee\xe4\xac\xa4?\xf9s\xcd\xbb\xd7\xf3\x1dU1\xd4y5d\xa5\xee\x1d\xb7\xa7Jh[U\x83	\xebm\xd3\xc9\n\x07\xbf;\xd5\xb4\x07\x87bA\x19\x03\xf4m'\xd2L\xf8\xf5E\xee\x17\xd4\xa7\xea\xd3\x88tO\x9b\xb0\x87R\x1d\xaa\x88\xe9k\xf9\xbe\xe1\x9e\x950\x0f\x8f\x8f\xbf\xa0\xff&\xbd\xf3\xe3y\xbbX\x8c\xe0\x0f\x143\xf0F\x15\xf9\xa2L\xc9\xe36\xe7\xe4/M`Uhi%\x87\\\x95O\xb6\x9b\x8a\xf4a\xc8X\xc6\x96ii\xef\xa0t\xc7RfU%M\xccI3\xf6A\xf2\xc4\x1e\x9ab\xed\x17\xcf\xd7nU_\xf1\xe2\xbc\xb8\xb6D\xbe,2\xac\xdfImq\x90c\xf2\xaagii\xe9\xae\xa4T	\x1b\x8c;\xde\x99\x10y\xed1O0\xcc%\xeab\x17\x9d\xe1\xca\x99\xd0;\x1b\x88d\x954\xcd2!\xba\x98\x89\x91\xde\xfd`\x83%\x1b\xaf,+[\x1f\x1e:\xb8 .~\x86\x0f\xecF\x1c\x95\xa0E\xc3i6\xfe\x0dz\xfb\x19_\xc1O\xee\xadP}\x9c\xd3\xdb\xf5\xb7)\x82\x8f\x10Y\xa9\xf4\x8c\xbe\xd5\xd9\xc2$r\x06\xa8\x9fw\xac\x156F\xb7\xfe\x9e^\x17X8~\xd0\x99\x97G_\xff\xe0P\x85\x87\xac\x92\xa5|\xa1\xdd{}\x13\xdc\xb9\x12d\xd7\x81\x1a\x806\x1b\x18\xbe\xb1\xe7iL\xe5j\xa7\x03\xf8\xf9\xd2\x8c\xf9\xf4\xfaF\x96D\xd7~\xf5\xe6\xc4\x18\x00\x00\x00\xe4\xa4\x94%\xab\xc4M?\xff\x7f\x01\x00\x00\xff\xffPK\x07\x08GD\x92\xa4\xd6 \x00\x00w!\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x10\x00	\x00gopher-fly-2.pngUT\x05\x00\x01\x80Cm8t\xb1eT\x1c\xde\x92\xee\xdd@\xd0@ \x04w\x0dN\xe3\xde\xb8\xbb\xbb;A\x1aww	\xae\x81\xe0\x16\x82\xbb6\xee\x10\x9c\xc6]\x83\x04\xe9\xe0\xf6\xae\xff\x9c3\xef\xcc\xba\xf7\xdcZk\x7f\xd8U\xf5\xab\xda\xfbybT\x95e0\xd0\x88\xd0\x00\x00\x00\x86\x9c\xac\xa4:\x00\x00\x10\x05\x00\x00l(H\x00\x00\xa0P\xc1\x9f\x1c\x00@\x97\xb3\x93\x90P\x95\x93\x90\xa0Pu\x05[\xdb9X\x01\x00\xb21\xe9:\xc8\x9a\x1acDy\x06\xear\xb6!(\xb2\xa8\x90\x18\xf5\x12\x05\xd4B)\xac$\xc6e\x149\x8a\xc2\xc1,,\xc9\xb3\xf1\x06\xb4\x1a#\xc9\xde\xc8\x9aX\xfa\x0e<Qhl\x07T\xaa\xbe3\x03\xdfJMO\x0f\xff=\x8f\xe6fIp\x8d\xdf\xa6\xdf\xd1\xcb\xd1\xe4:\xf0\xed\x19\xf6B\xebv4\x05(\xd0\x99H\x95\x87\xa9\x05\x00\xd0h\"\x1a\x06\x7f\xb0A\xc3\xff\xa2\xe3U|\xa2\x08\x06\xb8\x07\x8b\x98^\xde\xbb2\x08RR\xe2\x89\x12\xfa\xbf\x1am\xae\x87CUk\xda+\xda^\xf9_M\x86D)\xd2`p\xa2\xd8\x14S\xd8\xa3\x0f\x88X\xe4\xef\x00\xc0\x03\x05\xab\x08\x00\xd6K\xc1\xa6\xa7t\xa1f\x04\x9d\x98\x03M\x18\x9c8\xb3\xd6\x0e	\x06\xab\xd6\x0e3F\x8d\xfa\x1a\x0d\x9d+\xc5&\x06\xe0\xdb\xf0\xde\x98+`\xc61\xc4W\xfb\xa3\x08\xf2\xf5\xf1f\xe4\xb2\x11\x15\x96\x02`\x86Z\x82d\x12\xff\xea\x1d\x96\x8c\x84PQ\x81i6\x9a\x84\xe0v\xda\x0d\xae\xca\xd4\x16_c$\x9fO\xd7w\xca\xaa1)\x96}\xe7z\xc3\xec\xe9\x9c\x87\xa0\x8c\xbd \x05\xb1n\xf3\x99(\x04\x07\xaedf\x01\xb5t\xce\xaa\xb5\xf3.;vF\xc5\xb06\x99\x14\\|\xccz\xca\xc1\x8bA\xf8f\xd0 \xbdVI\xb4\xf2\xecr\xf1\xa1??2\x14\x9d\x8d\xd2\x13\xff\xb2\xdf\x82\x82'\x8aO=\x85\x1c\xbfr\xd1\xc6*\x8e\xba\x1dk\x007\x14/\xee}\xfb\xd8j{\\\xc4u4\x0d\x8bM\xb5\x83\xbe\xf93\xc5T\x86\xcb\xf2j{\xbc\xd0\xb7\xd5\xda\xf1\xd7&*`D\xf5A\x0f\x0b\xb6+\xf2\x83Ift\xca<\x17\xbb\xc5\x02\x95\xcb\x17\x92\xc1\x0e,\xce\x03\x1f9v\"\xe2\xc9\x1a\xc9\xc1\x18\xe2\xa6d\x06l\xad\xab51\x12\x8a4\xc9\x08\xbb\xfbz\x86\x95\x86Q\xc9jH\x95\xa8p\x16\xb0E\x97\xe6p\xd8\xce\xc5\xa3\x90\x9e_\x7f\xa21gZc\x04\x0c\xef\x12\xa7\xda\xafL\xb8\xffq\x9cn\xf0\xc3!\x15V\xa4\x8c\xad\xebH\x0d\n-\xc3\xaa?{i\x13\x1b\xb3\x9a\xe0])\x97\x88\x98}\xa2F\xc4\x83\x1a#\x04c\xf0\xf0\xba\x88\xe6\xdcP\xa8\x0d\x8bU\x16C\x84\x8c\x8eEQ\x16:\x18csp=\xfc\xd9v\xb7/N\x8cy;\xd8\xb9\x17\x1em\xb9\xa1\x97+\xe9\xfd\xe09\xe8\x17\xe82\xd3\x9a\x06\xfb2\x18}\x1f@\xe9<\xff\x05\xc9\xa6\xa4B\x86\xa6N\x05\x9ev[%:\\T\x98\x0b\xa8\x08b|'\xa5\xfaf'[\xf9{C\xb4\xc5\xb7I\x16D\x8d)\x14\xd9\x06\x18\x0c\xf6\xbf7(\xcc\xa3%\xbc\xce@\x0b\x8c\"\xba\x12\xe9\x82\x99U=\xd4U\x0c\xbfVXH(Ui\x17\x13\xec[\xfd\xc6\xd0\xc7\xa6\x8e\xde\x19\xb45\xa7\x99%\xe4\x1d\x08\xbb\x12\x15\xc2\xbb-\xac\xf3\xd9\x0dl{3m29CMD%\x0d\x97	'~G\xbb3\xa5y%\xbe\xc2\xfbP\xd7\xcbW\x10NF\xd5\xcco\x13\x90\xcb\xf9\x07\xa0\x07\xb9b\x13B\xb9\x96p\xfa\x1e\x93\x03\xc7\xeb\xcf\xf5wpX\xa2\x00\x0c\xfeq`K\xadc\xf5\xc6r\xb7\xe5z\xff\xf3D_0V\x10\xb1\x14\xbd8\\\xfd{wn\xc5i\xd1)h\xb0\xe8\xe7\xef%\xdc\xb9\xc1lnM\x9d\xa9\x99\xd1\xd6W~\x13-}@>\xfb\x96\xbey\\=\xc2\xb2\xde\x19\x8d'\x99`(7\x02\x9e'\x89}\x1f\xab\x99\x8c\x96\xb7-\xeeK\x8d\xb1T\xd8&\xf6\xe2\xd5\xbe\x18\xd9\x95\xc8\xf3U\x93\xb0VzZ'o\x9bWg\xd0\xc2W\\\xb48\xad'\xeam\xc5\xd4\xa9\xb4\x187\xc9\x08f\x9e\xf5\x87\x08\xe6\x9ap\x11\xd7w\x13\xf3\x1b\x03\xe4\x80E/\xc9F%\x88\xf9\xcf\x1eL\x14X?\xfe\x06\x15#@,%$$\x84\xda\xc4\x9d\x9d\x1c\xc0g\x8b\x01\xd0z\x90:\xf2\xc6uBv\xae\x15iM\xa9M\xc2\xb5so\xfbI\x0d`\x9c	\x03\xa71\xd4\xbdk\xe9E=\xdcC\xf5\x17\xa5@A\xfch\xca\x1c\x8d<FA\xaa\x18\xa2\x85\xd7w\xc3\x8c\xc0\xd9\xebP\x07H\x0bA\xab\x83\xafB\\4\xfd\xb4\x8c\xe6\x19\n2\x15\xb1E\x92\xe9\x95\xbb|\xdf\x86R\x13L\xbc\xcdj\x082\xda&<F\x04\x8b\x8a\x84R\xd0\x84'\xb0ai\x0c\xa2\xd5\xcb\x18~T\x19\xc4D\x95\xa6J\xd2\xa5\xf9('\xc3\x91L\"\x19J\x9f\xb42JU\x02\xaf\x17\xa1Im\x90\xe4\xbbO\xb7\xcf\xe6\x8a\xcd\x9f\xe8N\xe1\x95\x14%\xcb{\x8d\xfd\x10lN\x8f\xc1-\xce\xa2\x8a\xec\x8es\x12\xd50K\xc0E\x98\xfb\xf5\xb0`~\x16\xbb	\xc7(R\xbb\xd0\xe3\xd8\xa9	\x15\x14c,	.\x90\xffK\xb8\x1a\x05\xa7\x88la\x0e\xcd\">\x8cr\xa9\xf3fF\x98\x18\xb6\\6\xac\"\xa9\x1c<\xa9\x93\xae\xc2}\x9d^b\xa3\x85\x86AFb\xf8\x10lv\x9c\x9c\x11e\xf6\x18\xf8\xc8\xf3\x13\xa6\x872w\x17\xef\x95\xbbPE\xe2M\x06\xc8w!\xf76\xf0\xb9\xa6\xca\xce\xb4\xef\xb0\xda\xc2Q\xc3I\xfa\xd1\xc5	\x0bS\xear\x9d\xa3\x9d\x91\xba\x95\x8c\xc2y%\xa9\x92\xe5\xdci\x1e\xfb[\xd9s\xb3\x14\xbeK\x19\xca\xaf\x8b\xae+\x08\x89\x9dQxb?\xc9\xd2\xa40\x8c\xa5\xe3H\xc6\xd2]\xc9F\x94p\xcc\xf9\xce9\xcd\xb9\xcci\xa9\xe3\xff&\xd5\x8br\x90\xba\xa5\x91I\xe1\x1c\xc5\xbe\x16w\xa5\xf5\xc1\x7f x`|@m\xcf/R\xa9/\x7f\xa7\xba\xcb\x00?\xae8\xee:\xce#\xcft\xc0Q\x1a7\xa7\xc2] \xa0\x1e\xf6cl\xc1\xf0\x04\xfe\x0bns\xb2o\xa4o\xe2\xa3\xbc\x8c\xcc\x88\xf4\x88\x89MW\x82\xaeM\xb7r\xfa\x94p\xae\x9b\x00\xa6\xc7\xe6<\xba\x1d+\xd7\x07Gl%1\x03\xe951A\xb1N	?\xa9\xf2y\xd2\xe3\xc2\xbf\xe8\xbe\x94\x01\x84\x90\xbc\xc0\xd8\xd7\xe0W\xe5\xccBA\xf5\xccJ\xa6\x99\xdcr\x83\xe2\xb5\x12?\xad\xbbv2\xa9i\xe3\xc4j^\xa9Z\xc9Z\xf5\x8b\xe0M+\x08Y@lw\x98\xb0L~\xb9\x88N\x8f\xf4[\xdd+\xcb\x8b0BdH\x94X\x97XH\x9fz\x98\x11\xd2\x11F\x1eQ\n:):5:bdi\xa4\x01\x86\n\xa1'\x07[$K\xd8\xc06'\x1e{\x0b\xfb\x14[\xc7\xd7h\xe9\x04i\xc3b\xc3b\xb4b\x90\xf4\xb94d8r\xb88^#>.\xde\xfek\"\x11\xd37\x82\xf9\x901\xabC\xceL\xce;N0\x87\x08z#\xc10\xd9g\x92\x16K\xdd\xbd\xba\xca\x81\xcdlt^T\xee\x8f\xd9i9g\xdc\xedf\xd7}iVDf\xd96\x1dsM+\x7f\xbb	\x9d\x0e\xcdy6\x1d\"W\xa4\x1b9\xadA\x03\xf1\x91\xc8\x1f\x8e0\x81\xe4\xddA\xf7\xe0\xc3\x1f\xedq>E\x0f\xcc\xb0\xea\xc3\xcc\xc9'ff\x96\"\xe6\x12\xbcS\x16e\xbd\xbf\xba\x1c,\x12,n,K\xbad\x8dg-\xe6\xba\x88\x061\xd5P]\xd2\xd6\xc70\xabI\xf3\xc6k=\xad\xaa\xf9*c{\xb7S[\xfb\x88\x1b\xbcS\xcf\xd3@b\x99\x1c\x9c\x9c\x08\x1e?\xc7?k\xea\x8e/\x0e\x8d\x8eK\xcb-+\xb7\xabz+\xf1\x8d9\x8eK\xa6w9\xa0\xa1\xcdic\xcb\x9e\xa9\xd7\xaa\x17\x03d\x99(\xae\xa8\x0b\xd2l\xd2<\x81l>WR\x05\x12b\x92\x0b\xfe\xd9\\\xf1\xefe\x82\x8b\xfcF^\x9f\x05\x9e\xf8\x8f\xf8\xc1\xce\xdd\xbb\xa1\x1d\xde\x9d\xd1\x86_\x0cY;\x8bj\x84k\xcc\x970jH\xa9Q\xa8\x9fed\xa5\x17\xe2\xdb\x0e\xa4\xdd,:q\xdb\xb9\xdb@$c$\x84\x82\xae;\xa3\x87\xc9\xd32+\xf1\xe3\x04\xcd\xae\x0b+j\xf9\x1b\x90\x0d\xd8Fk\xfb\xd6\x0d\xdb_\x82\xd3\xfa\x99\xe2\xc5\xbd\xd2\xf28\xba\xad\xc6aX\x07\xfe\x06\xd3\x9c}\x9eN\xdcR\xdclMqD1oB\xf7\xf9\xafK\xb2\xa3\x17\x88W\xce\x1d\x1d\xb9	\xf97\x95b\x95\xd6\xda\\p\x19\xb8\xfa\x82E\x84\xba\xe7]Oi\xe0\xd13\xea\xab\xd9+\xec9\xf1\xbe\x07\xf3\x12\x95\x1e}\x99\xfcR\xa7\xc0\xc0\xd4x\xbb\xa6\xb7\xdc\x82d\x04e\xc4\x81x\x9e\xd0\xf2\xd3\xe0\xfbU\xec\x12tkD\x1b$\xf0\x07\xedH\xdd\xa8K\xf1\x13q\xb2\x88\xd5H4\xf1\xd6(a3\xc1F\xb7\x95\xce\x95\xe9\x81\xf5\x01K\xb3\xa8\xfeMWx>7>\xba\xfe\xfa\xcb\x1f\x97\xd7\x97\xb7(\x03\xd1i\xd1'\xd1\xc8\xc9<\xc9\xfc\xc9\x18\xc9\x10\xb5M\xb5\xc6\"e\xc9OE\x16E:\xd2[\xbb\xfa\xce\x0f\xeb\xbeN\x17\xba[\xf5n\xc3y	\x85C4C\x9a#B\xb1\x1e1\xe9C-	\x12#\xf1\xa4\xad\xbe\xeb\x10\xbe'>9C7\xa1\xe8\xa3\xe1\xda\x84\x9e\xfc\xed,6\xd0\xce\xd1\xd5\xe0\x15\xea\x9e\xfd\xb0\x88\xf2_\xd9\xef\xb9\xde<1\xc9\\\xdcv:s9\xfbu\xfb\x07c^\x1a\xdf\xd5q\x18\x1c+|+R\xd5O5\xb6\xca\"\xca\xab\xe55\x94	\xb8\xcd\xa6\xc2\xe3~[\xff\xde\xfd\x9b\x81\xae.\xfde\xf8\xf6kw\xcc\xc7OK\xcag\xd9\xfb\xa0\n\x87\xb9_c	\xa9qt\xf9\xf9\xe6<\xb4A\xe3\xb3\x15\xbe\x1a>\x1a\x98\x96\xd8\x1c\xf3\x1c^V\x81\xfbC\xb0\x13\xef\x00\xbd\xf5\xaa\xd5*c\xe1\xa8\x9e\x847E,F]\xeac<$T\x1d\xd4|Kd\xab\xda\xf1\xc0\xecOi\x8d\x02\xe5\x068T?qn\xa2\xf9w\xbf\\\xc5\xad\x1f\xaf+\xb5\x83a\xbb0\xcf}\x8c\xfd\xf2\x83\x0b\x16\n|K<\x92\x0c\x02\xf9k\x9a\xf2r=e\xa6<(\xa5Gz\x86\\\xfa<BYm\xee&S\\\xb2c&\xf5\xf5\xd8u\x868\xb5\xb7iO\x89\xb3\xba\x7f\xb9\xb7.\x8e\xa1\xa6\xc6_'\xe9\x93\xaf\x08CHC)\xbb\n\x9cF\xd6\xac\xdf\x1eJh\x1a\xf6G\xf4\xee\x99_\xabZV\xa0\x8d\xf8\x93\x81|Q \x9b	CK'\xe8z\x8bO\x0bL\xfe\xc8\xe3Wbv\x8f\x1b\x9c\x87\xd8f\xa8	~\x07\xd0\xb0\xf9\xf2\xa6\xa8I\xaa\xba\x14\xaf\x14\xa6 \xd8\x13\xf7XS\xa8\xd8\xaa\xa8\xedy\xfa\xd4{\xc1\xcb2\x8c\x1f\x87\xdf\n\xbc\xd2o\x08\x1c;r\x15{\x88@\xbb\x8b\x1d\x8fMTxP\x00>o_I,b\"L4d6\x03\xef\xf4\xba]\x10]\",\x87~\xa5S2y\xd5p\xe8j5\xfct\xbbi[T\xb2\xd7\xb5\x1f_\xea2\x16\xf48\x7f\x18\x9fM\xcf\x81\xaa-1@\x19*\xcd+\xf5\xa1k\xd5&\xf5\xaf\x16\xcd?\x1e\xa5\x95t\x7fm\xea\xc3Vi\x9b\xc1\xbc(<\x86\x1b\x04\x1d\xf5.\xa9Ce\xb1J\xe4<q\xbc[\x9b\xd8\xcd\xa5\x15S\x855\xaeL.\xf6\xb3\xd0\x915\xf5\xd6\xdc\x97\xd9\x8b\x91\xc7s\xeb5\xa6\x96\xa0U\xca\x95\xc2\xf5om0w\x088\xe0\x85\x15f4A<\x83$%q\xf7G\xae\xb3\xf6\x0cuk~\xee\x18\xdaf\x05t\x03\x0f	\xd7\xa9\xd4_$\xe6s\x04u\xb4\x8bX\x07\xad\xc1\xf5 \xc4#\x9fcB\xc9\xccI\x18\xc1\xe2\xd9\xd6<7<q\xd9\xe9,\xbfYH\xa3F\xc8\x9c\xec1\x1c@\x7fa\xa3\xf9^$\xf5\xc2\xean\x0b\xf7\xc7\xd7\x16\x0b\x16\xa5#H	\x99$\x17\x82\x94\xa0Y7\x8e#K\x9b\xb5\x85\x96\xce\xf2\xcf\xdf;\x02}\x9f\x7f\xdfr\xb2\xdbL\xbd`?LT4\x9e5\x8eL#\xe6\n\xba\no?\xef\xbc\x0f\xc4\xcf\x06>\x1a:t\xec\x07\xfc\x9e\xa9\xdd\xec!\xfbrg\x07\xee8\xf3k?\x88}K\x87&\xb0\x93\x8e\xd4D\x1a\x82|X\x9eW\x81K\xedK\xc0\xcd\xe8\xceY\x17\xce\x83\xc3s\x83T'\x06\xf0{'\x11Hj\xd0\xd1\x10X\xaa\xe7f+7(\xd1\xb9\xf6\xb9\xfb\xf5e\x1c\xf9\x10\xf3\xe4\xf5\xe0\xb9\x16\"O~0\x1b\xb5\x83	\x04\xc9\xfb\xba\xb7\xa6n=\x9cy=\\\x90w\xca\x93\xcf\x93\xeb\x08\xd7\xfb\xaa\xbf-\xc0~'\xff\x19\xfd\xc3\x14\xa4\xf8\\|\xfa\xed\xaf^\x90\xcaJ>R\x10T\xd8\xcb7\xf0\xb2v\xa6\x87\xf5F\xf9\xf8\xdc\x97\xd2\x8f\xfd\xd2\xf57\xd3\xbc\x8e\xa0qb\xe0\x064s\xe6n[\xa9/\xe7\xb5\xf5\xe0Bq\xeb\xdc\xff\xe2\xee\xf5,\xaa	S\xe5\xd1\xc6\x9f&\xa0\xeaq\xff\xb9\xe2\xc6m\xf2\xc8\x0f\x01\x11\x01A=\xefk\xb90\x9ai]\xe1\xca\n\xa0k\x1d\x0e\x00\"\x05\x0c\xe3z\x07f\xfdh?\xaa@\x9c]WZDy\xa6\xabj\x7f\xffs\x089X\xfa^\x95t\x89\x9f\x0e\xb0\x8c\x9c3\xe0?\x8a\x91s\xf2#\x8f\x04M\x15X[X\x86\xeb\xd3\x92Y\x1e\x19\x87\x04\x16\n\xf7B@\xa8e\x06P4\xc3_\xca\xf1\xb0\xcd\xf4\x98\x99n\xcf\xf3\x10\x9c\x91\x02\x94\x15\x97\xd6\x81Z\xe2\xe1^a\xbf\n\x19\xdd\x07$\xd9\xc8\xfd\xa9\xdf\x90(\xbf\x07\xb5ok?|\x02\x00\x00\xa8\xce\xb2zn\x00\x00\x1e\xed?\x07NN\x9d\xe6\x12\x00\x80G\xb2\xd3\xd4u\xd7UR\x14\xb0\x00;\xb2\x9aY\x82\xcd\xadX\xbd\x1d\x9d\x01\xff\x84\x90\xb7\x80\xb7\xa3\xb3\xa3\x95\xbb\x19\x85\xb7\xa3\x83\x93\x9b\x807\x88\xea\xbf:\x04\x9c\xdc\x04\xfeI\x03\xa9(\xfe\xab\xc5\xdd\x1eD\xa5\xab\xa4J!\x01v\xb5\xa2\xe0f\xe5be\xa3\x12F\xa3\xa0\xa0\x10r\xb5\xb4\x16P\x97\x94\xfe7\xeeji\x0d\xa2\xb2uww\x16\x00\x02\xbd\xbc\xbcX\xbd8Y\xc1\xae6@v~~~ \x1b\x07\x90\x83\x83\xc5\xd5\xd2\x9a\xc5\xcd\xc7\xc9\xdd\xcc\x9b\xc5\xc9\x8d\xfa_C\xfe{\x8e\xa4\x95\x9b\x85\xab\x9d\xb3\xbb\x1d\xd8\x89\xe2\x9f\xbb\x999\xd8\xc3\x1dDE\xf5\xef\x9e\x7f\xc5\xbf\x16Yy\xdb\xfd\xcf&'\xb7\x7f\x7f\xcb\x02\xec\x08\xfc\xa7\x02dge\x03\xfe'\xcc\xdd\xce\xfa\xff\x81\xfdS\xf9\x17&\xfc?\x9c\xd0?\xc3\x04T\xed\xbc\xad\x1c\xf4$\xed\x1c\xad\x9c\xdc\xec\xc0N\xc2\x1cl\x1c\x1cB\xc0\xffX\xfa\x8f\xa8\xee\xffBy\xf8\xd9\xfe7\xaa\xfb\x1f\xd1\x7f\xde\"\xa0\xe2jg\xe5\xe4n\xf6\x8f\x18\xc2\xecB\xc0\xff+\xf7\xdf\xc2\x01\xff\x0f\xe5\xfee\x0b\xf0\xdf\xbe\x08\xa3	\x01\xff\x7f\x8f\x85\xd1\x04=\xb8j\x00\x00\xecF9I1M[\xc4E}To\xad\xc5fc\xafIE$\x0e,Yx\x1a\xd9\x14q*9z\x9coT\xb1s\x8c_*\x93c\x1b\x8f\x11p^\x98U\xfd\x11\xadl+\xb4Kw\xed\xfb\x8a\x84\xebp\x0dp\xc3\xd1> >2\xd8\xfa\xa3g\xb3}\x86\xd3DA5E\xc2N\x11uJ\x11\xdc\xcf\xbb{{\xca\xfb\xf4\x07\x83\x1e.`\xdc\xa0\xee\xcf\xca\xd1w\xc8\x921\xcc\xd8/?\xda_\xc4\xf4:\xe73\xc69!\xc8\xef\xf6\xde^Yyjk6\x90\x88\x0f\xea\x9d\x9f\x9f\x0f\x96Z\xeb\xe9\xb9:y;_\xe4\nz\xf5o\x9cz\x99<\xca\xf3S\x16t?N\x82\xae\xafW5\xb7\xb4|inn^-S\xcai\xcay\xef\xb4\x19/\xbc\xf4(g\xf7\xf5\xca\xef\xef\x00\xef\xaf\xa6\xa42;\xbc\x82\x06\xec\xda\x1c^p\xcbIFc\xf0\xd0\x14\xb4X\nu\xa5\xb6\xeb\x1a\xad\x83\xc9\xb6\xafv\x02s\xe3\xfc\xfc|\x95\x18\xdcUXH\x0e\xea\x07\xccuZ\xf6Bq\x95M\x1d\xd1\x1cP~QJ\x0bz-\xfe\xd4J\xdb\xdcs\x17\x80\xe0,\x8b&\x8e\x0c\x1c)w\xaf5\xdb\xe3\xa7\xd7\xa5 x\xb4\xd1\x08\xf5a\x85N\xe2\x0c\xba'>\x9c\"\xa4\x92/\xf3\x04<\xfe\xcd\xbd[w\x9b\xc6\x13\xf2\xfb\xe8M\xcc\x86g\x1bL\x8a\xe1\x1b\x86\x1aJ(^%\xe2sM\x13\xe18\x1bam\x00\x97\xbes\xe3\xbb\xe62\xdf\x02\xde\x12f4#D\xfd\xbd3\xbfRy\x7f\xe2\x870\x80\x01\xa3S\xcc\xaa\x0b:\x85V3\x06zt/\x1e,Th\xe8\xbb\xb8\xd4\xdf\xee\xa7\xaa@\xc3*i{\xbb\x03\xd7\xd5|\x90\xe2\xb3r\x9c:*\xd6w\x9c\x9d\x0d\x89\xd3\x01\x85\x82\xb2\xf5\x18R\x97!\xef\xcd\xa4h\x85~\xce\x17r\x04a\xb11\x07M\xcc\x95*`HoX\xe6)>R^<\x18VV\xd4\xcc\xe6\xf9\x9eT\xf08?\x19\x14\x98\xf1G&~\x14\x8f\xa1\x96I\x10R52\xfa\xf5g\xfc\xdc.\x00\xc1=\xe2G\x96n\x977{:\x1b\x81,\xdb\xf0\xd3\x9f\xee(\x93\x01\xc70\xeb\xc9,\x17M\x9b\xbat\xbc\xb9\x0d-\xbdDGR;~\xc6\x10q;++\xab\xd5\xac\xca\xe1\xb4\xe0i\x87k}UUQ \x1b3a\xe8\xad\xe0Z\x8b\xa3\x85N\xe8\xfc\x16\xb1l\xe2\xb4!k\xa6\xe7\xcd\xa2\xce\xc5\x8e7)z\x04\xd1]n-\xd7\xb2W\x19\xbd\x04\x9cY\xe3\xd1\xa4\xb0X\x02\x80\x15\xb7\xd3\xa6\x0bz\xa7\xf9\xb6h\x94\xe5\xc5\x98\x91|\xd6\x14\xf1\x1e\x7f.\xf6\xee\xd1\x85h\x7f)\xef\x03\xf8\xea\x84R,\xf1\xba\xc9\xcf\x7f\xb3\xe0(\xba\x05\xe4w\xeb\xfc\xcc\x1c\xf0\xb5PT\x04\x9bT\x89z\xf2~\xdb?\xf6\x84\xc0\xbd;\xf2#|\xaaN\xadqu\\\x05\xf3\xd9\xe7\xb0H:\x02\xe0sm\x07\x00G\xde\xa0\xd5\x894\xc2{\x1c\xcc!HF\xach]VR\xe2\xaf\xa9\xabk\x8b^\x8e\xdc^\xa8H=\xd8\xe4\x03;\xe4gk\x89\x12\xde\xdc\x1eK\xfcN0L\x94\x10\xf5\xda\x1c\x1e\x94\x1fg\xff\x84\xcb\xdeJ\x8dC\xc4\xc4\xc4\x84!=F\xf1\xcd\xf1M\xc0\xea\xb8f\xb2\xeerf\xa3\x0f\xeeS	\\SVO\xe7\xe6\xdb\xe5\x00\xe6RZff\xb1\x1f\x05\x89H\xe0w\x0b\x93\x19du8M\xb2\x91FR4\x1aC\xb7\xb5S\xb0mkOsd@:\xf6\xdfUI\xc8s\x8bH\xa4\xdfP\xe8L\xaa\xdb\xd1\x14\xe8u\xc5&\xdf\xce\xd1q2\x00\x8d\xbed:\"\x80\xbf_D\xa57\xcb\x98q\\OM\xad\x90\xf6V\xd1p-\x86\x10O;\x92Wr{\x83\xdc\xe4~\xe2=wt\x04\xae\xb70\xa9\xb7\x85\x86\xc6\xc6L_\x18\xf2\xc1^.nsx\x9f\x02\xe0\xdbdh(\x84\x13\xd3\xbf\xe6\x97\x90s\x12\x9aw$\x10\xa6V\x80\xe8\xfe\x98\xc1\xfb\n=A\xd2\xffTK\x108$\x90\xd6\xa2$\xe8y6CDL\xdcg\x8f\xcc\x16G,\xff\xdb;z\xf8\x91_e\xd2\x87\xc4\xa2\xf3\xb4\x8b\xa0f\xc2\xe0\xed>an+h#B\xfe\x18\xf1\x99\x9d\x0d\xf3\x9e\\$\xef\xb1\xe0\x96\xfa\xe3\x11nj\xb9\xad^\xeag\xa5aN\x06z\x16\xda_n\x8c_/P#\x0f\x03\xbc\x8e\xde\x9a\x0f\x0f\x0e\xbe\xd5\x1dv\xbet|.\x00\x11y\xff\xa2N\x14\xe2`\xe6\xe5m\x0d\xf2\xf4l\xc1ua\xd9\xe8d\xe2o\xa7\xa9q\x8d{\x9a\xf6L\x85\x8c\x82I\x8e\xca\x9a\x9f\xfb\xd4\xd9L\x83\xad<\xec**q&1	\xd8z\x872\x8f-\xa5mj>?\xc8\x0dD%\xca\x93\xb2s0ux]\xe4\x99mtFE\x9a'<\x0eD\xd1\x12\xc1\xa4\xa4\xa4Z\xc1>f\x15VG\x95A~\xc4\x01Gpw\xf0\xa8\\d>e\x1c\xc7\xb8\xc6/\xaf\xa1\xb8\xc1.hmtl\x8d\xba\xec\xdf\x14\xcf\x87%\x0c\xf9\xc5\x04\xd8\x90\xbd\x87\x16\x90\xcbos\x04\x0e^WVV|\x86\xda\xf0\xbd\x83\xad\xae\xf7\xc7\x0e3\x8d}\xc7\x06\xfcH\x15u\xa9\x95|\xa20s\xa8\x87\x13\x97Z&\xf3/\xdeXQ\x03\xd0\x1a\xfe\xb4\xb9\x89\x0f/\xd7K<\xa1\x13\x7fV\x83\xa4\x0c\x92\x11U5\x90\xa5\xf5/\xcf\xba#):\xd3i\x1c\xe4A\x8c\xe3~9\xd50\xae\xd0\xbde9\x9f\x12}\xb5a\xf7@'x\xe7\x0e\x8f\x10\xac\xdd\xa6Z\x90T\xf6\xfc\x00\xb3\x82u\xf9\x9c\xab\x8a\xda0\x19\xd3C\xa6^\x08\x8d-\x82{\x9eW\x8a\xa4D&\xba\xfe<F\xdfO<\xc6-\x9a\x17\xcd\xb0fM-\xd4\xc4\xe8\xd9\xdfe\xabE\xff\x18S\xb2\x06\xa5t\x19\xd7/Ws\xd5\x13\xbf0\x97\xab\xf0d\xf3\xbez\x8a\xc1Z\xde|_\xf1f\xd6P\x14)\x8dL\xf9\x9d\xb9/C\x85z\x19\x9d\x83P\xdd\x83\xe6\n\xc4\xa9B,\x85\xd0	\xd0Krf\xd9\xdc\x83:\xae\xecL\xdf'\xe1m\x83\x8c\x92\x0c\x1b)b\x91n\xe1\x0e\x87\x0f\x0c\x85\x05\x9e\x86M\xbe\xca\x12\x83\x04\x84\x19\xe8t\xc4\xa9\xa9\x97c\xfaM+\xae\xf4)\xeb\xf4\x14\xeb\x08\xb1bG\xb0\x10l\x11\xb6 e\x85\xad\xf8wl_7\xac]Bc\xb61\xf3|\xa0\xcd;\xf5zL\x81\x1d\xc7\x89\xad\x87\xeb\x02\x94\x87\xbd\xc3\xf1\x0d\xb7!r\x93\xa4\x82c\xc7\x0f\xc1i+\xd3\x18;+\x99\xa8\xb1\xa7\xe7\xd1_\x0d\x9c\x08\xd3fD `I\x7f\x7f\x07UI\xc9p\x16\xbee\xa7\xfa\x95A\xcb\x10\xe5\xde\xb0\x91\x03\x1c\x95\x9c\xf9s\xcf\x96\xe6v\x92\x04m^F2\x1d{\x05\xe2\x0c\x14\xccG\xbd\xb0\xfe~j\xac\x01v5\xb9\xb1\xc2\xfa\x97G9M\xf5\x94wQ\x11\xaa\xc5\xe7\xa6\x86\x03P\x14h\xe4vG[,+?\xdf\xfb)\xfa\x81A\xd1\x029,R\xe5L\xbae\xebV\xdf\xeb\xf2\xe9:\xde\xa5E\xa5\xb1N\xc7\xa2\xc1\xbb3\xca\x9c	\xf9\xca\xd9\xc2P\xc8\xd4\xd7\xfd\x8d\x15Qu\xdfh9\xa2U\xce\x9a\xec\x9e\xed\xa3\x97k\x98\xdf\xa0\x8d\xa5\xbc\xad\xe2\xd7e\x87\x0c\x186\xfb\xe8\x9c\xb2d(;{\xad(p\x06a\xed\x9b\x90\xf1\xaf\xdd\x9b\xa6\x1c%Y?y\x82\xc5\xa7\xdd\xa0\x02\x07\xd4\x06\x83\xe6\xc8\x9f_\xee\xa0\x99&-\x03X7\x11\xd3B\x01\x08\xf4N\x88\xfa\x18\xc2S\xdca=\x97n\xb5/\x03\xf3nQ\xeb\x01\x89\xb4\x0d2]\"\x038\xa3\xefj5\xae\xa2\x99\xbdx@mT\xe6\x0bt\xad\xa8\xd6pD\xa9vl,\x1b\xfaC\x9cds\xd5\xe9\xf6\xc8m\xad\xb3O\xbb\xb5\x9a\xe2\xd1\x1b'\x9b\xad\xbf\xb7Z\x0e\x96\x8cal\xf4\xf5\x14\x1arW\x0e\x91\x010\x82~\xd8\xe3\x1fjA\xafx\x851\xc2\x92\xdd\x8f\xd6\x1c!\x14\xf9O\xc3)v[\x04/r#\xa4y\xe2\xd0\x0f.\x08\xd9_$e\xa6/tM\xc3H\xf7\xc6\xbbL\xeb\xf5\xeb\xe2\xe6\x99\x83z\xee\xfe\x14A\x98t\x1a\x02UE\xc5\x04\x10\x1e\xccS\xa8\xba\xderb\x8b\xf7|m\xfe\x82\xdd|\xba\xacq|\xb4e\xed#\xed\xfbU\x85\xed=t\x15Qg)S\xc0\\Z\x03G\x02\x8f\x89\xa8$\xe5^o\"\x1d\xce\xac\x0e\x0d\x93\xb2\xb1\xc6\xa9\xbf6\xed\xa7O\xdf\xe8\x075\x0fD;\xbb\x03\xdfM:\xd0\x0c\x8f\x903l\xf2\xb3\xb2\xd3V\xfc\xdaq\xe7l\xa4f]\x814&\xc1Y\x00W\x88\x19\xe1\xa6\x8e\xcfa\xa9\xf9I#\xf0C\xb6\xc6y\xd0\x15\x04\xefiq\x1a\xab\xb3\x0b\xbc\xeet\xde\x1d\xed\xb8\xf7\xa7,,5U?\xa4\x8d\x88D\xf8@\x03\x1cfU\x98I\xe8\xdc\x00\xb4\xea\xda1J\xef\x84 Y\xd1\xcd\x82:\xd5\xc6\xbd\x85\xdf\x97\xc5k\xad,\xa9?\x19Ul0\xd9p8<.s\xbdfA\xeb\x8a\xaa\xc2;/\xa1\x0f\xc8\x0bp)\x9f-T\xc5\x1d\xb5Q\xa5g\xa9\xb5\x9dQ-\x05qlC2\x1bTL\x87\xaf\"(q\x86\xa5\xd4S\\\xdd\x80\x03\xb4a\xe6\x04\x7f\xa6x\"l\x92\xcd\xc9\x04\x1cW1f!\xa9C\x12\x11\x8c\xad\xe5\x97\"M\xa7\xf6\xe3\xe3\x94X]+\x1b\xac\x9d\x9e\x17\xe9\x87a\xf9\ni\xc5z.P\x02\"F\xca\x17\xd6\xd4\xc9	`\xe6\x0f\x8a\x9c\xa6\"L\x8d\xc4\x0bd\x1dxm\x92\x05\xecs\xd4o\"EUz\xbd\xeaA\x88\xaa\xfc\x860N?L\xc2\xec\x1as8\xa2N\xae\xaaZ\x06\xaf\xf2E\x85\x0f\xfe\xac	\xdc\x95V\xe6\xd2\x91\x96\x18S\xe9\xa3\xc7\xb6\xa5\xc1\x1aJJ\xea\xda\xfa\x8c`\xa7u\x8a(p\x97\x9akCc\x89\x12C\x1f\x1b\x89\xed}\xd5q\xbc\xa2b\x89\xf7\xb7^\x00\xe66\x88\x92Z\xd8h\xec\xe2\x80(\x80\x81F\x18\xdc\xa9\xd3~w{\x9e?^\x10\x04NC.WJuF\xf9\x83\xb9anA\xcb\xc0\xa2$\x1dOH=\xf1v\x1d\xea\xafk\x02,\xae\x9d\xcc\xc4\xa3\xef[O\xee\xda\x91\x8a\x8e\xdf\x1e\x95\x8d\x99\xfe\xa5T\xbd\xd1\xae\xb1\xb8\x17\xfb\x81\x87W:\xa9<<\x84\xe0\xefp|\xc2\x1b	\xfe\xc6g3\x04`\x1aJ*q,\xc1\xca\xdb\x81\xd9\xd8j\x93\x985\xe8*\x1c\x9b\xac\\\xb5\x1f>*&q4\xd0\x1f\x8f\x84\x8c\x15\xf3\xc57\xc3P\xef\xef\xb7\xb3M\x9dXC3\xb5\x97\x85\xae\x0e>\xfa\x95R\x1bm\xbc\xe9O\x028\xa6\x1b\xea\xcf\xe9.\x14\x1f\xed\xde_\xe5r\x84\\\xb6\xce\x01\x08\xc3.b\x95d\xde\xd7\x9c\xe8e6\x17P\xf6|+E\xb4\xae\xf4{\xca\xe7\xb0j\x108\xc2J\\c\xd1\xae\xd4\x9a\\v\x9b\x91\x95\xd4\xff6}\x9f\xe0L\xfe\xe8\xbb\xa9\x07Tb\xc5\x1c\xf7\xa8\x14\xae\xd5GS\xb2>c\x1e\xd8\xdb5_NtQ\xa1\x04]O)\xacW\x7f\x14\xa2Q\x84\x06\xb4>\x0ey\xcd\x1f\x98\xa0\x8d;\xc4\x07T}\x7f\x07\x0d\xb1f\xaa\xc1{\xdf\xc0\x85;\x8f\xb8\xcda\x83\xd0\x18\xd5\xfd\xac\x1e\xf8\x91\x9cn\x16\x15u\x99\xb69\x0e\x97\xb8f\xcatf_\xf0\x16\xf5\x1c\xaa\xf4\xd9\xb2\xa4\xb2\xcb\xb4<<\xd0\xed:#\x87\xd70\xb4\x02\xe0:0\x9f\xc1\xdf[\xda+h\xc8\"\x8f\xef\x1bjy\xf7\x1e\x81\xc3\"\xa2\xc0\x915S\x13\xf2\x96\x87^[\x9dfo\x18\x8f}\xb7\xa2]\xb1\x1e\xa4\xea\xa0\xdb\xe2\xaf\xb0\xf8p\xfc\x88\xb3\xa8j\xcc\x8a\x90\xfc\xb7\xfb\x03_\xfe\xc7\x9a\xfb\x13tZ\xce\x91\x98\xbf=g\x1c.\xda\xda?\xfa\xa5}\xd0\xf2*j\xdaVCC\xdb\xf0\xb7\xa9\x18\xf7\xf2\xf2\x03\xde\xf2\x8a#\x82\x0c\x96\xb5[\xfe\xf4a\x04\xe5\xe5\x05V]\xa5\xe1\xd3\xdc\xff)\nn\xe6m6V\xf7T\x95\xc6\xefa\x10\x01\xe91?\xc8\xbc/]*\xc3/\xfd\xa8}S\xbf\x84;\xf7y\xab\xaf\xef\xf7\xfc\x1c\xcb\xc9\xfaHx\xe4\xe5\xd77Z\x85\x0c\x14\x8cN\xf3!4\xa9.\xe2.\x8aw\xc3\x96V\xe5\xdf\x83U<\x8e8\xf5\xd2\x02\xb4\x8cT\x8d=v\xf7\xd9\x1d\x96\xae\xa6h\xcc<\xe3\xdaT\xb8+\xce\xd7\x08\xd7\xbc~Y-V\x15\x98s\x1b\x0c\x86P\x1e\xaa\xf0L\xd4%S\x0f\x12\xe3\xb0\xd6\xf0$\x92\xb0\xdd%\xdd\x1bC\xfc\x89\xe3Y?\xeb^oGH\xb9\x84oSo\xfd\xce\x1cK\x0c\xc6\xfc\x19j\xe1\xf1}e\x7f\xacD.u_W+}\xf9\xcc\xd3e; \x98V,in\xfb\x13wj\xc4\xcfw\x98\xc2/\xd7\xab!\x84{e\x8a\x18\xed>\x7fv\x93S\xf3\x03\x80mW%\x17X\x05{m\xa7_\x19\xf9Vx\xe1$\xe2Gt\xd2G~\xee\x8f\x0f#`\xe7g\xd1\x1b\xeb\x96\x97V\x88\x8dK\xec\xd16\x18\xf0q\xf0hmp\x9b\x8a(\x18V-h^\xa1\x87a\xd9\xa2\x0f\xdcP\xd77}t6\xad\xac\xf9\xa8\x8b\xf1\xc5\xfe\xcb \xe1\xbcQ\xf5\x8dtB\xf0\xe6XQ\x137w^\x7fi\xfax\xa1\x0c\x0f\\\xe6\xd8\xeb\xc6\xbc\x0b\xa4\xf6\xca\xb6T\xa2\x9d\xe1\x8b\xcd\xba	\x01\xfd5\x95\xd6\xd3\xedxR \xe8]'\xd0*duU\xa0BY9\x95\x8d^F\xaf\x9a\x89\x88\xa25\x0b\x84j\x13\xfa\x86\xf0\xfe\xd3\xe4%\xab\x04\xc1\x16\x04XQVV6~K\xbaf\xb5\xd3\x1f\xf1\x03\xdbK\x81F\xdcl\xe2\xf6|N\x90\x13\xf6\xf5\xc9\x02P\xb6\xb8\xaaF\xbc@\xca\xaf^<\xbc\x17\xb5\x98\x1f\xe0o<\x82\x9f\xb1J\xfd$\xef\xd9r\xb9?\xdb\xaf\xf2\x14BtT\x00\x86\xe7\xbcU2l1\xbf\xbb\xc8\xe8\xb6\x0b\x18:\xbf5\xe5\x9e)y\xae\xfcd~\x91S6U\xbe\x03\x08\x86\xd9\x07C\x87x\x0c\xd5\xb1w-\x11\x98\xf5\x1a,\xa2\xe4=\xefqp{Cw\x10%\xa2\xd0\xd3\xf9\xdd\x0eG)\xd2\x06\xa2\xe4\xe5-\xb9\x0f\x7fo\xd1I\xad.\xe8}\x92\xba\xf3\xc9\xfb\xc4_\xea{\xf3\xc5CR\x05\x93\xa2c\x93A;*C\xce\xf6\xcdu:nb7\xb1Jo\x08\x02\x18\xfc-\xc0\xd6\xc8\xf9\xb4\xb4\x98\x9f\x06\xc7\xa25\x87\xb1H\x94I\x93\x87\xf1\xc7~\x81\x86\x9d_o\xe8-\xe2G\x1aR\x01qB\xc7\x03\x9e\x8f\xff\x8b/\x7f\xe0\xb1\xea\x08G4\xb8i<\xe7X\xedg5q\xe5\xed2\xbb\xcf\xeeb:\xc7\xf3\xad \x0e\xcc\xdf\x86\x1e\xb5?\xfb\x8bh\xb1\xd8[\x89\xf4\x8e\\\xfe\xc4\xf6Z\xcf\xdbb.\xb2\x88\x8f\xdb\xec)\xb7\x81\xb1r~f\xeaq\xe4\xdf\xee\xdc\x02\xe56\xb7g\x86\x93@\x11\xcb\x8c\xf6\x1bI\xa5\xea\x12\xa9\"\xd9\xfa\xd5\x85?U\x1f\xd1V6X\xa4\xb0\xcd\x8b\x07\x11\xa79\xb2\xae\x88\x1f\x9b\x952vY\xdb\x1c\xdeu#\xa2\x7f\xe7W(\xab\xf2Wj	\xba/\xf4V\xbb\xac\x9b2\x85+Ir\xa47_\x82V_\xcf4X\xa5\x08Y\x94IRn\xf1\xad\x19vc\x99rH\x92\x93h\xe4T[\x95^\x1f\x1by	\x87\xcb\xcd\x8f^D\x1f\x05rV8\x9f\x0c\x9e\xd2\xf0q\xac\x87\xb9\xfe\xc4\xe0D\xea$\xc1h\xb9\x8c\x1d\xd8\xec(\xd1t#0$\xd8\xea\xe8\x98\xf4V\x90\x08\x1b\x94\x8a\x0c\xe3B#\xc4\x11\x89\xfa\xa0#\xabq\xa8u2\xb9\x0e\x97hZ\xe8A)\n\x97\x8bR\xfag6\x99\x03\xdd\x04z\xf8r\x1a0\xf68\x9f\xbf\x1f\xbb	\xa1-\xf4C\x7f\xc75\x86:\xd9\x0e)\x12\x8fM\xd1\xb0\\\xf8NGC\x15>!4\xb5\x11v>\xde\x8f.\x98\x17\xfcG\xc4\x7f\xd0\xd51i\x83\xfb\x04Se-?\xc8\xe3`\xba\x02\xe0e_9\xef\xd3t\"\x99t\xbb\x88j\xbf\xf7\xdb\xfa\xb4o\x9c\xc8\x1161\xbd P\xf6\xe6\xd5\x9f\xf4\xa4\x01!kE:`'8\xee:\xc2D\xc3\x80^\xa5\xe7;\x03\x13\x0d\x84\xf95\x81/\xc3\x18\\\xdax3m\xce\xa6\xd7\xc5t\xcb\x85]\xa7`\x02`\xb3n\xcb\x92\x96\xb6\xb9\x87\x9d\xa6C\x84%\x8d\xfc\xf5\xb2\xbd\x95\xbfdV[\xefg\xafP\x13\x92\x8a4\xb6Z\x99\x93\xd8\xb8\x92\xe9c\xff\xdf\x8bLj\xb1\xd0\xda\xd4\xa1O\xe0\xd4\xd1{o\x8edy\xc4\xb3,\xd8\xb3}E\xef\x95-l@\x9a\xc5\x99\xae\xce\xd7Voe\xaa\xcf\xf8\xb7c\xbd\xde\xfa\xe8\xf7\x16\x18\xa9\x06q\xbd\xe3\x00\xf6\xc2\x8c\xbb\xbe\"\xbd$c\\\xec\xfe^\xde\n\x07c\xa1`\x95\xed\xd9\xa4\xe8\x0bU\xf0\xce\xcc\x07\x1dO\x8a\x8a\xa1\xed'1\x7f\xeb\x8e\"\xf3\xac\x88\x8a\xd9\xd9\x934S\xdd7X\xa2H\x06\x93\x88\xb7Z\xec\x17\xd0\xf5\xefkn\xec\xebNDu\xaeGF\x1f!J\xe3\x13\x95|\x9ei\xd1\x06E\x05\x15\x8d'\xf3C\xb8\x9a\x18C\xd1V\xb8;x\x15\x80A\"x\xebV\xca\xfd\xed\xf5\x93FC#\xd6\xbb\xcf\xfb\xd8\x03\x9f.oID\xb7\xd8(Q\xb3CCK\xad_\xf4	\x93\xf1\x80\xb55+\xf45k\xe5rB\xeb\xf86\xafF\x1f\x8a\xdd\x92t\x12\x8fc\xe4?.Su\x16\xa0\xcf\xd1\xec\x1e+\x07`5\xec\xb1\x95\xec4Ih\xbf\x05\x162C.\x04\xc6\xc6\xe43\xfb\x8b\x02\xd4\xaf\x86\xf5\xe5Bl\xa6\xbe\x81\x0c\x02_\x96-\x1a\xcc\x1f\\5\xd4kK\xd0\x91\xa8qm\xa5\x11\xadH\xb7\x1a\xcbi\xa9@\x1b\xfe\xfb\xa3\x1f\xc7\xc5/\xf8T;<\xaby\xad4_\xb3\xc8\xec\x02\x18\xba3\xc9Y(\x10\xdf\x10\xe0\x07B&w\xf6	\xec\x9d\x7fhF\xd2\x8dv\xc6\xe1)\xbc@\xde\x18^X\xc9\xa3\x8d\x88\x94mM\xfb.\xd0\xd2\xa8TG\x7f2\xf1S:\xeeWP\xaf\x99\x0e\xf0\x9ad\xba7\x1a)k\xeb\x8c\x1e\xb3\x0f\x94\x90\x14^'S\x1bn\x1a\xddd\x87GF\xf8\xa4\xa6\xa6r\xfb\x17p\x0b\xb2$\xd8\xc0\xd3\xa2\xec'H\x01\xe8\nB\xf1\x14\x0do\xa0\xebb\x88\xbc\x1aL\xac8\x1f>\x87\xbf\xc3\xdd'V\x89\xb8O\x1c\x02\x9d\x86H\x0f\xdaiz\xa3\xdc\xee\xad#\x9d\xe6j\x8d\x04o\xbeR\xbf\xac~\x87\x06\x83\x8f1\xfb\xf7?\x1d\xbd\xce\xb97\x88ynxC<\x976\xee\xbc\xb3\xc3$\x9a\x0e\x88_i9aE\x93C&\xd0\x1c2\xe3:I\xec]s=\x98\xf7\x95\x0e}\x1f\xfd\xf7<)?(\xaf\xaavj\xce\xe1\x9e\xa2iw-\x153\xc1\xe3L\x13m1\xf9\x8b\x96\xbfa\x8ffF\xa2\xa1\xb0\x1a\xe3\x0f$\x1d\x8a@Rs\xbd2\xa4\xeb(\xa6\xe05	\x8f\xab\x9e\xda\x9f0\x88d>\xe7\x86\xc0c\xfe\xe2e\xfb\xb7\x863\xbd\xbd\xbf^u]\x91\xa1\x18N1,\x08\x02\x01\xaf.\x85\xd5\xe7\x10/\xa5\x11\xf5yIA}\x86\xbb\x0f$4{\xc3\xf1\xe9\xc7\xf4&\x15]6\x17d8\xf2a\x9bb:\x81d\xef\xb9H\xc8\xbf#(\xa6\xe4\x83\x93Y\x0c\xb2,d\x0ffw8\xad\xd7W\xde~V\xcec#[\xa3\xaa$:\xc8\n\xb8;\x8d\xef\xd7\xceN\xddy\x93\x02^\x03\x10\x8c\xb7\x87MD\xa4E\xf6N\xee\x94\xf9\xf1.rY\x1c\xe7\xd4u\xe8d\xbc.R07_\x8f\xf0o\xac\xfc\xb6\xa6W\xd5\xd6/\nu\xb4\xd1\xfb>\xb0\x17\xd5\xd4tCJJJ\xe0\x05\x87uf\x02\x92\x85\xc9\xd1`5aW:x$}\xf3\xc5f\xbdj\x90\x91\xafc\xe7\xfcl\x95*\xde\x86\x0eE,44}\xe6Q\x1cu\x9aWm\xe3\xa2\x1f(\xd8\x8c\x17\x19>\xec\xa6\xeb4\xba\xfc\x1c\xe0\xc9\xe0\xff\xac\xda\xd1\x88o\xdf]9Dy\x9f\xc4`M\xe2\xbc\xec\xb2;a\xc1\xcc\xd4r\xc97%F^\xdb\xd4\xb9\x91]\xd9\xbe\xb2\xb0:3\xe3\xff\xb3\xec\xf1\xb2~\xa3\xb3[\xe8qvDq?\xa2W%d\x98\\\xc2\x99\xfc\x17\x82\xe4\x9f\xd7\x04\x04\x8c\x97Y\xeb\xcd7\xc4\xf4Q\x9e\x1f\n\x92\xc1\xe2\x00\x00\x00 '\xa5,Y#n\x1a\xfa\xff\x05\x00\x00\xff\xffPK\x07\x08\x0b\x8e\x19h\xc7 \x00\x00h!\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x11\x00	\x00gopher-normal.pngUT\x05\x00\x01\x80Cm8t\xb1\x05P\x9c\xdf\xb2\xee=\xb8\x04\x82\x06Ip	\xce\x0c\x10\x9c\x19\xdc\x1d\x82\xbb\xfb\x0c\x0c\xee\xeeN \xb8{pw\x0b.\xc1%8\x04\xb7\xa0	\x0e_\xfd\xcf\xde\xe7;\xa7\xee\xdd\xb7\xab\xde\xb7ju\xf7\xaf{\xad\xe7\x89TQ\x92\xc6\xc6|\x8f	\x00\x00\xb0ee$\xd4\x00\x00\x80\x08\x00\x00\x00\xa1\xa3\x02\x00\x80|y\x1fJ\x00\x00K\xd6F\\\\EV\\\x9cJ\x05\x0e\xb3\xb4\xb1\xb7\x00\x00d\"S\xb4\xd0>\xab\x8f\xbe\xcf\xd1S\x93\xb5\x0eD\x97\xc1\xe8\x8aT+\x92\xc7\xc8\x97\xc4M`\xfe\x89.K\x95?\x90\x86+q:V\x8fYm \xd1\x1bV\x1d\xc5\xd8N$\xb2\x18\xd5\xbe(Y\xd7\xf1\x95\xd8BUG\x87\xf8\x0d\xcf\xe7\x8d\xa2\x80j\xef\x0d\xef\x83\xe7\x83\x895\xe0\xeb\xd3\xf53\xbd\xf3\xc1$ Ok\xfc\x8b\xdc\xb5\xaa/\x00\x93.\xb4~\xa0\x0c\xb4\x18r\x83ETN@\x15\x00p	\x106\xbe\xb8\x833	RS\x13\x89\x90\xfa\xbc\x18l\xac\x85,\xaaT\xb7\x95\xb7\xbe\xf0\xbf\x18\x0d\x8aP%_#\x88\xe0SM\xe2\x8f\xdc\xa3\xe0R\"\x03\x80{\xf2\x16\xa1\x00\xdc\xe7\xbc\x0d7\xa9\xfc\xcf\xa1\x0c\xa2\xf6t\xc1\x08b\xac\x1a\xdbd\xd8\xec\x1a\xdb\xac\xd8\xd5j\xabt\x0cp\xaa\x0dl@\xfa\xd0\xce(\x1c0\xed\x10\xe8\xa5\x89'\x8cvu\xb8\x11\xf6\xd3\x80\x06W\x1e0M+N6A|\x89\x8c+-.T\x90g\x9c\x81).\xb8\x95\xfc\xe7\x9d\xf2\xe4&_C\x18\x9fgg\x16u\xe5\xa8$\xdb\xaec\x9d~\xc6T\xe6\xbd\xff\xd7\x1d\x7fy\xd1n\xd3\xe9p$\xfbO\x89\xac\x02\xaa)\\\x95\xabg\x9d6\x1c\xcc\n\xc1\xad\xd2I\xef\x88q\xea\xa8\x07\xce\x07\x10\x9b\xc0\x03\x8c\x1aE\x11J3?\x0b\xf7}\xf8\xd1\x16\xb1@\xd4n\xc4\x17\xdf\xcd\xa8x\xc2\xf9\xd4\x92(\x89+\x16\xac,\xa2i\xdbp\xfb\xdf\x05\x11E\xbfi\x1b]i\x8b\x0e\xbd\x8a\xa0c\xb3\xaa\xb2\xd75}\xa2\x9a\xfc\xea\xf4s\xa5-V(}\xa5f\xec\xa5\x91\x06\x18Z\xb5\xd7\xc3\x86\x0fG\xbb7J\x8dH\x9a\xfb\xc4a6O\xe3dK6\xd0\x8e\xcb\xb5\xe7)\xcb\xf1\xfe\xc3D\xb5\xc4@\xe4\x87\xc6D&|\x8d\xcbUQ2\xaad\x89P\x9b\xbb:\xa6\xe5\xfa\x11\x89\xaa\xaeJ\x11H\x1a\xb0Y\x9bn\x7f\xc8\xc6\xc95\x9f\x91_w\xbc!sJ}\x18\x86\xe8\x14\xad\xf2]\x89t\x17o\x8ca\xe0\xed>\x0dn\x98\xb45|\xb8\x1a\x9d\x9ei\xc5\x87\xa3\xb8\x11\xc4\xaa*x[\xfcIX\xd4.^=\xf4^\x95\xb9\x0b{`\xff\xaa\x80\xeeL_\xa8\x15\x97]\x06[\x98\x82\x81MAfq \xd2j\xefj\xe8\xa3\xf5\xaf\xbehQ\xd6\xad\x00\xc7^D\xcc\x9f\xf5\xbd\x9f\x12\xde\x0c\x9c\x81\x7f\x80/R-\xe9\xf0/\x02\xb0v\x01\xd4\x8es\xb6\xa8VE\xe5\xd2t\xb5\xca\x88\xf4[\xca\x11!\"\x90O@\x0503\xb2\xa4\xca\xab\x8dL\xc5\xd1\xbaH\xb3W\xa3\x0c\x98\x16G(\xac\x150\x10\xe0s\xa7\x97\x9fCOz\xf5\x15\xd3/\xfc\xfd\xa5p\xe7\xb5I\xe5}m\xf9\xd0K\xb9\x99\xb8b\xa5f!\xc9\xae\xc5\x11\xb6.>m\xc4\xf6\x80\xb5)\xdd\x0c)o\x7f\xf0\xa5\x88\x10\xd1\xdf\xfcZ\xcf_~\xad\xaf\xc6\x8dF\xa7\x18\xf1\x18\xe4!\xd2!\x1f\x90\xe9\xb7'?_\x8a-\xf3\xde\xd7\xf6\xf2\xe5\x85P\xd04\xf1[\xf9fs\xfd\x06\xe8t]\x82\x84\xd0\xaf\xc4\xa1Y\x91\x99\x08\xbc>\x9fn\x06\x86\xc4\xf3`\xb0\xb2=kZ-\x8bW\xb6\xdbM\xf8\xdd\xb7c]\xc1(A\x94b\xac\xc2\x10\xb5\xac\xee\xec\xf2\x93\x82\x13\xf0@\xc1\xb7\xa3\xa5w\xb3\x03\x19\xdc\x9f\xb5&\xa7GZ^\xf8\x8d4t\x01\xb9\x1c\x9b\xba\xa6\xd1uH?uN\xe9\xdc(\x04\x83\xb8\x91\x88\xdc\xc8\xec\xfa\xd8M\xa45<\xac\xdf=W\x1bJ\x06o\xe0/\\\xee\x8aR\\\n?]6B4R\x92;x[\xdd;\xfc\xe7c\xdeaFk<\xd2n)|\x99L\x8et\x96\x08e\xe5Y\xbb\x0fe\xad\x0e\x11\x86#\x8f\xcf\xad\xf7S\x02\x16\xdc%\x1a\x14\xbbL\xbf\xf5\xe0\xa0_\x7f'^\xa7a\x06\x88&\x05\x06\x06\xd2\x1a\xb9pP\x02\xf8\xac\xb1\x01\x1a\xf7\x92\x07\x1e\xef\xa0h\x8e5\xc2-I5	\xefl\\Z\xbf\xd1\x02\x98\xa7\x83a\xc9L\xb5\xc8\xcd\xbd\x18\xfb;\x18>\"T\xe8(x\xc6\xac\x11h\xa3T\xe4\n\x81\x1aD}\x7fX\x91\xb8z\xedk\x01\xc9\x81\x98\xb5\x88\x95(\x0b\xc6\x04?1\xdd\x82\xc0\xc6\xc2\xd6\xa8\xd2\xbd\xb2\x17oZ\xd1\xab\x03>l\xb1\xeb\x83\x0d\xb6H\x0fQ`\"\xc2ATt!q \\\xf5\x01\xcc:i}<\xe5\x01\x1c\x0c)\x9a\x04m:<Yi\xceD2\x89 \xc6\x84\xe5\x11\x9a\"D\x9d\xd0\xcf\xb4z	^\xbb\x0c\xbb 8>\x7f\xbc\x0b\x95{B\xb8\x0c\xef\x15\xfe}\x80)#6\xb7\x18\x9b\n\x9a\x0b\xe1qx\xfd\x0c\xc9'\xd2\xec\x98\xfd\xbc\xb9\x19\xfcFB\x830\xcd|\xd7Ch#\x068\xd2P\x02\x96'wC\xba\x12\x8e\xa0\x80ff\xba\x98\xf6a?\xdc\xa9\xd6\x83\x15i|\xc8\xfc\xa7~%Y\xc5\xc0q\xadT\xe5\xbb\x97\xa9%\x10\xfdbp\xd7p$\x1f\x92\xd56\xd4\x11Ez\x87\x89\x8f27nj0\xf5\xd7\xc2\x9dR'\x86p\xacQ?\xe5\xaf\xae;+\xc4lc%Gzd\xdc\xd6\x10\x8c\x10\xb2\xefXb\xa4\xf9I\xb5\xd9\x8e\x11\x8e\xa8\xdd\x8a\x06!\xbc\x124\x89\xb2.t\x0f\xdf[8\xb2\xd3\xe4\xb3$\xf5\xe5\xd6D\xd6\xe4\x85DO\xa9\xdc\xf0\x1fe\xe8\x92\x98FS\x08%\xa2\x18.eB\x8b8g\xbdf\xa1\xb3N\xb3\x1aj\xc4G\xe4:\xe1\xf6\x92\x7f\xe9\xa4\x93\xb8F\xf0\xaf\xc4\xe0\xf4\x9e\xc4\xf7$\xf7\xcc\xf7\x18m\xb9\x05\xcau\xa5\xc8*\xbf\x98\x10\xc7\x14\xc6\xe0c<r,{\x9c\xc5\xd1\xb3\xca\xdcy\x02j\xc1e\xa3\xf3\xfa\xc7\x88\xb6\xef\x9a\x12\xbd\xc2\xbc\xe2\x1f\xe4\xa4\xa5\x87\xa5\x86\x8d\xac:\xe3\xb4\xad\xba\x95R&!\xd9\xce\x028\xae\x1bsX6\xec\x9f\xde:\xe0+\x8a\xeaI\xad\x8a\n\x8av\x88{K\x96\xce\x91\x1f\xe6\xdf`yQ\xfb\x92v\xe5\xf8E\xbd\x04\xbc(\xa5\xe6\x0b\xaa\xa5V\xb0Lg\x97\xea\x15\xae\x16yk\xdc\xb6QHN\x19\xc6W\xf1J\xd6H\xd4\xa8\x9d\x07lXtQ\xf8Fu\x07C\xa4sK\x85\xb5z\xa4^k_\xd8\x9e!Ha\x81\xe1\xa2\x9d\xa2\x81}j\xc1\x06\xa8\x07\xd89\xef\x93\xb0\xc8\xb1h\xb1P\xc2\x8a\xc3\xf4\xb0\x95I\xdd8Aal\xc1\xfd[\\D\x1c\xcd\x1c\x93\xa0\xf6\x98\x08\xa98)\xfdB\xfdB\xccB\xb0\xd4\x99T\xd7P\xd8Pa\xaczlt\xac]L\xfc{\x96t\x92\xb9\xc0Q\x8b}\xaeT\xae[.\x18\xa70V\x03\xc9\x10\xc5G\xb2fs\xed\x9d\xda\x8a\xfe\x8d\x0c,^\x0cn\xbc\x8c\xe4\xccS\xee6\x93\xab\xbed\x8b\xf7&\x19V\xed\xb3\x8d\xcb7\xdd\xa4\xd0}S\x9e\x0d\xfb\xb0e\xa9\x06.Kp\x7fl\x18\xda\xdb\x03\x1c e\xb7\xff\x1dl\xbf\xac-\xda\xb3\xe0\x9e\xf5\xbaj?u\xe2\x91\x95\x95\xad\x80\xb5\x88\xe8\x84MI\xe7F\x9b\x93M\x9c\xcd\x99mI\x9b\xa2\xe1\xb4\xd9T\x1bE/\xb2jQ\x9b\xbc\xe5!\xd8b\xc2\xb4\xe1JG\xa3r\xae\xd2\xd0\xce\xf9\xc4\xda.\xf4\x0f\xd1\x89\xdb\x89\xdf\x07\xe9L\xc2\xccP\x1eo\x87\xdf\xabj\x0e\xcf\xf6\x0d\x0eK?\x9b\x97\xff\xae\xe8,\xc76d:,\x19\xdff\x82\x077\xa6\x0c\xcd{&_*\x9f\xf5\xd0\xa4\xc3?\x85\x9f\x93g\x90\xe7\x08d\xf0\xc1\xc9\xe5\xc9>\x90\x9d\xf3\xcfd\x8be\x95\x08.\xf0\x1b\xb8\x7f\x14x\xe4?\xe0\x879v\xff\nj\xf7\xe8\x88\xd0\xb7\xd5g\xef(\xa8\x86T\x9b.aW\x93\xd3\xa2\xd3>I\xcbH\xcd\xc7\xb6\xeeI9\x9bu\xbck\xe3n\x05\x93\x8d\x92\x91\n\xc2\xb7G\xf6\x13\xa7\xa4\x97c\xc7H\x9a\xe0\xf3\xcb\xaa\xb9\xeb]\xeb\xd7\xeb-m\x9b\x7f@7$'u\xd3\x85\x0b;\xc5\xa5\xd1\x0c\x9b\x0dC\xd7\xed\xc4\xeb,\xb3v9Z\xd1K\xd13\xd5\x85\xa1\x85\xbcq\xddg?.(\x0e\x9e\xbb\xdc3o\x19(\x8d(\xd3\x95\x0b\x95[j\xb2a%\xb0\xaas6a\xda\x1e\xe4\x9eb\xbf\x83'\x8c\x17\x93\x97\xeb\xa7\xf8\xbb\x1e\x9c\x0b\x0cF\xac\x9f\x94\x17Zyz\xc6\x86[\xd5\xbd\xa5fd\xc3\xe8\xc3\xf6\x1f\xe6H\xcd	\x06\xde\xac\xe0\x17aY\xa2X\xa1\xc2\xdej\x86i\x87_\x88\x1d\x8bQ\x84\xae\x84a\x8a\xb5\x84CL\x04\x1b\x9c\x97;\x96\xa7\xfa\xd7\xfa\xcdM\xc2\xbfo\xc0\x11\xf9\x9c\xf9\x18\xbe\xd7]\x94]\\]\xfcE\xef\x8fH\x8e8\x8e@K\xe4I\xe4O\xc4N\xecR\xddPm(P\x92 (0+\xd0\x92\xda\xfc\xa5\xebx\xbf\xe6\x05=\xd7\xde\xacs\x1e\xca\x89\xcb\x1f\xa4\x1b\xfc<,\x14\xe5\x1a\x992\xd8\x1c'>\x1cK\xde\xe2\xb5\xd6\xc5\xf7\xc8'\xab\xef,\x14q0T\x13\xd7\x93\xbb\x95\x06\x02o\x1f\\\x0e\\b\xec\xd8\x0d	+\xdd\xc8de{\xf0D&~\xe2\xb6\xd1\x9a\xcd\xdc\xad\xdd\xdd\x1buW\xcfR#dr(\xf7*\xff\xa2v\xa2\xbeY\x12ZZ%\xa7\xaeD\xc2m2\x19\x12}dy\xf4\xeb\xe6+\x96\x9a\x94\xed\xd0\xdf\x98\xeeH<\x82%\xa5\xd3\x8c]p\xb9\xfd\xec\x8f\xd1\xb8/\xd1\x0c\xb9\xb9\xa6<\xf4\xfec3\xe5^\xea\x9e\xea8\xe6\xf8\x9cs\x9c\xee\x16~\xbb\x83\xd7\xc7\x1e\xbe:k\x95+\x95\x86\x90\xf0\x9e\xb8W\x05\\fm\xdaC\"T\x0c-\x8c\\s4\x8b\x9a1\xbf\x0c\x82\xe4\x06\x81R=B\x9ao\x84\x7f\"\xf8\x7f\xd9^F\xaf\x1d\xae)\xb6\xc1\xae\x7f]\xbb\xedb\xef\x96\xee\x9d\xb3Q\x11\x9b\x13\x91}%\x91\xbb\xa2+-\xd5Qb\xc9Y\xa4vM\xf9*\x9b2\x87TR\x93\xbd\xc1\x12\x9d\xe8\x90J{5z\xf5U\x8c\xd6\xc3\xb8\xa7\xc8Q\xcd\xa7\xd4C\x9bP\xff\xb3\xfa\x0dT\xea8\x06i\x10u0\xe9\x97<\x97\x81%{\xfa}\x11]\xfd\xee\xb0\xce\x1d\xebKe\xf3\xf2b\x03\xf1\x84\x1f_8\xd8j\\\xdf\x1c\xba\xb8\xd6\xec\xd9|-w\xe0\xfa#>\xa3\xc7\x19\xc1Ut#\xc8\x88\xb8\x1d\xa8\xdft\xf1\xa7\xa0Q\xb2\xaa\x98\xa8\xf8Z^\xb0'\xfa\xa1:_\xa1EA\xd3\xed\xe4\xb1\xf7\x9c\x97m\x888\x9a\xb8\x05x\xa9[\xef7z\x00\x17\xbd\x0f\xc5\xbc\x8d\x1a\x8b\x8a\x97\xbf\x97\x07>m]\x8a/\xe0 \x8d\xd7\xa76\x01ou\xba\x9dP\x9cB\xcd\x07\x7f\xa4P\xb3\xb8Wsjk\xd4\x7fs\xfe\xd3\xba\xa0h\xa7m7\xb6\xd4i(\xe8zv?6\x93\x92\xb9\xa8\xba\xc4\xb4\xc8TaZ\xa1\xbb\xb8ZeT\xf7b\xd6T\xf6 \xa5\xa8\xfdcC\xf7z\x85\xbe	\xc6\x8b\xce\xa3\xbfN\xd2^\xe7\xf4e\xb0$J\x91\x92'\x9aws\x03\xbf\xa9\xb8|2\xbf\x1a\xce\xe2d7\xb38\xbc\xaa\xd6\x92\xfd<s>\xfcpf\xb9\xca\xd2\xec\xbfB\xbd\x9c\xbf\x96\xdez\xed\xd2\x05\xf3}f\xbf6\x18\xff0\x8d*)~\xfb[\xb6\xa3\xe6\x14csn\xf6p\xb1\xd5\x02\xe8\x0c\x1b\x84\xd4*\xd7\x9d\xc7\xe7r\xfa\xb7\xb7	[\xfa\xaf\"\xf4 \xc5\xa2\x9d\xe1,R\x98\x921\xc3\xc42,y\xfe\xf0Dg\xa4\xb0\x1d\xb1\x91\x87\x0fS@\xed\xb0\xed\xc17\xd7#\xb9\xeedu\x105\xe7\xf9\xbb\xc3+\xb3y\xb3\xe2a\xd4\xb8T\xb2sAj\xf0\x8c3\xe7\x81\xb9\xd5\xea|sG\xe9\xc7\xacv?\xaf\xa7\xa3\xbf\\\x1cV\x93\xcf\xf8\xf7\xe3\xe5\x0d\xa7\x0d\xc3S(\xd9\x82p\xc8\xd6\xd3\xf6\x1b?\xe2\x0c\xe0\x83\xbe}\xfb\xae\xef\xd1t\xcdF\x0f\x85\xed\xad\x0d\xac\xfd\xd4\xbbm/\xea5e1\x8e\x83|\xb8:L\x1f\xec\xc9\xf6\xb4\x02\\j[\x02nDt\xcc8q\xed\xed\x9f\xe9}\x812\xc1\xde@\x85\xbb\xbe\xf8\x1f\x0c\xc2${\xfelf\xfb\xc7;\xd6<u\xbf<\x8f\xa1\xed\xe3\x1c\xbf\xec=\xd5t\xc9Q\xee\xcd\x84o\xe3\x00\xc1r^.-_6\xefO\xdd\xef\xcf);\xe4(\xe7(\xb5 u^j\xaf\xf3\xd7G\x89\xbfG~\xb3\xf8+<\x15\x9e\xa4\xdf\xe8\xf8+/\xe7\xa2\xfa/B\xdc\xbd\xfc.j\xa6{\xd8\xff(\x1d\x9eyQ{s\\\xc0\x8fX\xe6\xb4\x04\x0d\xe3\xfd\xd6\x17S\xa7o\xb7\x14\xfb2_Z\xf6\xce\x156\xcf|\xceo_N\xc3\x1bq\x94\x1f\xac|\xe8|+\x1fv\x9f\xca\xff8O\x1cx#\xa1 !\xa9\xe5\xc4\x94B0\x8dk\xf3\x97\x97\x01\x9dk\x08\x0009`\xe8\x9d\x87_ZY\xdbA9\xca\xcc\x9a\xe2\x02\xfa\x13Ce\xdb\x9bo\x83h\x01Rw*\xe4K\xfc\x0c\x80\x9fh\x99\xfd>#\xd8\x99\xc7e9d\x98*\xc0\x9a\xfc\x92w\x9e\xcd\xa9\xa5a\xd1\xa80\xa1\x10w$\xa4\x1aV\x00U\x13\xe2\x85,\x0fh\xba\xc7\xc4xk\x8e\x87\xe4\x94\x1c\xa0\xa4\xb0\xb4\x06\xd4\x10\x0bq\x0f\xfe\x91\xcf\xec\xd2/\x01\xa2\xf4\xa1}E\xa5\xce\xf2o\xdb\xd2\xbc'\x00\x00\x00\x18\x8e2:\xce\x00\x00\x11\xfd?\x1f\x82\xac\x1a\xdd\x05\x00\x80\x88j\xf3Y\xdbE[QA\xc0\x0c\xe6\xc0nb\x0e3\xb5`\xf7pp\x04\xfc\x13B\x1e\x02\x1e\x0e\x8e\x0e\x16.&T\x1e\x0e\xf6Pg\x01\x0f0\xcd\x7fu\x08@\x9d\x05\xfeI\x03i\xa8\xfe\xab\xc5\xc5\x0eL\xa3\xad\xa8B%\x0e\x83[Pq\xb3\x7fb\x07\xd1@0\xa9\xa8\xa8\x84\xe0\xe6\x96\x02j\x12R\xff\xc6\xe1\xe6\x96`\x1ak\x17\x17G\x01 \xd0\xdd\xdd\x9d\xdd\x9d\x8b\x1d\x06\xb7\x02r\xf0\xf3\xf3\x03A\x9c@NN6\xb8\xb9%\x9b\xb3'\xd4\xc5\xc4\x83\x0d\xeaL\xfb\xaf!\xff=G\xc2\xc2\xd9\x0cn\xe3\xe8b\x03\x83R\xfds61\x85\xb9\xba\x80ih\xfe\xdd\xf3\xaf\xf8\xd7\"\x0b\x0f\x9b\xff\xd9\x04u\xfe\xf7\xb3\xcc`\x0e\xc0\x7f*@\x0ev\x10\xf0?a.6\x96\xff\x0f\xec\x9f\xca\xbf0\xc8\xffpB\xff\x0c\x13P\xb1\xf1\xb0\xb0\xd7\x91\xb0q\xb0\x80:\xdb\xc0\xa0\x10N\x10'\xa7\x10\xf0?\x96\xfe#\xaa\xfd\xbfP\x1e~\xd0\xffF\xb5\xff#\xfa\xcf]\x04\x94\xe16\x16P\x17\x93\x7f\xc4\x80p\x08\x01\xff\xaf\xdc\x7f\x0b\x07\xfc?\x94\xfb\x97-\xc0\x7f\xfb\x02\xc1\x14\x02\xfe\xff\x1eC0\x05]?U\x03\x00o\x0fe%D?[\xa3\x9c\xe9\xbeq\xd1XH\x7f\x99j\x8b_\xdb\xdd\xe8\x94\x15\x13\x13KCN\n\x90\x95EGU\x955M@D\xfa\xa9#\xd0\x0b0\x95\x16\xc5\x1b\xd4=\x9a\xb5\xb1\xb3	vm\x9b\x9f%\xed\x90Z\xa5\xffLr\xa9!\xa9\xe2\x83\xdd\xa8\x8b\xbb4o\x8d\x11\x8f\xeb\x93\x17\x02H\x0f\x93\x06\x85\xec~l\x8bwV\xea9_\xf3\xfe\x8e\x9d\x81V\xadi?\x9e\xfb'\xd7\xf7\xef\xce\x93\xf7\xeb\xd4\xf3\xb9/-\x1c\x8f]n\xab\x00\xf9\x7f~/Jt\xc6h\x94q=\x04\x86\x93\x99\xd8z\x03\xc4r\x03\x8f\xc2\x03\x15\xef\xf7\xaf\xe4\x92\xd5_\xf1\xf8J\xc2\xca\x9d2|\x14\xd2\xb6\xcb\xafs\x1ec\xeb\xb6\x06\x15Q\x8fKp\x99YX\x967\x81\xdaI'\xee\xd3Kys\xe6\xad7_\xf5\xc8R\x16\x16\xcc\xa3\x14l{\xa9j	\x18\x0b\xad\xa6\x0c\xb9+\xcd\xb3\x8a\x88\x0d\xbf\xdb\xbe\xa3<\xbb\xde\x7fV\xec@$\x86\xe1p)\xf8\xd1\x04\xaeL!\x1bb\xb2\xa2l\xf8\x1c\xcd\xae\xc6p\xd0t\x8f\xa7\xae\xd9\x12n\xb8\xccUY\x7fY~\xbc\x15T\x95\x18B\xe5\xb9\x0d\xaa)\x0c\xb1N\xf9\xbb\xdc`4\xcdc\x9f&\xce\xa6\xa7\x12\x92:\x9a\xae\xa5\xaf\x9a\xe3\xbcWT0pz\x8a\xe5\x0e\x87+(\xc0\xeb\xb3l\xadq\xe7cC\xee\x90}\x1f\x81\x94\x0dg\xba\xaf\xbb\x961\xcf\x1c\xb5\xecO\x1c\xeea\x80i\xf8\x07\xb0~jO\x08\x80\xf5\xfd\xc1\xd7\xac\xcc\x12[\xd1\xdd\x8e\x85!\xb5\xc4\xe5\x82d\x9e\xe4ou\x0d\x96\xa4\xab\x15\x156\x8bD*\xe3\x0d\xc3\xec\x9d\xdb\x9bW\xd5\xdd\xed\xf6\x03\x03\x91I V\xf5,\x0e\xf6\xfcdP\xbe(\x96\xbc\xach&\xdc\x1c\x96\x0du\xe6[\xd8\x7f\xf0\xbd:]\xe3\xff\xc0q\xda\xd1\xfb\xd1\x98p\x1a\xed\x14e\xb1\xc0\x9b\xfb\xadJ>a\xca\x98\xc3\xaa\xb9\x9eEq	\xa3\x9cQ\x0c\xb6H\xc9\xd4o\x8f\x9b\xe9\xf0\x03%\xa3?\xd7\xa4f\x96\x13\x17E1c\x04\xe1\x8a\xd4s~eJ3X\xaa\xd8\x8aZ\xd8\x06\xbc\x0cZ\x9f\xa3\x90\xf2NL\x9dW\xca\xf7\xa9\x88\x8a\x9b\xfd\xddt\xd7\xbd6\xe5\xce;z<v\x05n\xa0(\x96H\x07\x88\x1f\x02\x81\x80\xa9q\xc4S5\xddo2\x0el\x8aL\xf8$\xc9\xad\x87|\xc6\x9f\x12\xfc\xa4\xfb\xd4@d\xab\xd8\xe6y\xc7\x98=\x8f\xaa\xb3\xc8L2\xc4 <>D>\xf6&\x0f{\x8b\xed\x1e}\xef\xbd\x91\xa8\xa8d\x05\xe4\xc6\xb4;mN\xf2^c\xf17\xc7n\xa0\xca(\xc4Y\x11t\x8a\\\xed\xder1\xd7\xfe^krW\xc6\xa1\xa3\xda{c\xf2\xea\x16\xbf\xe6\xb1\x96\xbd\x9c\x8e%\xd1<g\xb9\xfd	\xc5\x87\xc3\x16=\x8b\xb9_\x92;\x97\xaag\x01\xab\x12i\x9c\xc3(\xbdB\x98\xb2\x00CY2\xe7u\xd7\xc1\xf2{\x14\xda5&&\xa6\xeaV\xbe\xf8\xfe=\xf8J\x96\x12\x07w\x107\xf23d\xc6\xa7;\xa0\xa7b\xfc\xe0\xfc\xf4\xf4\xc6[P\x00\xc9\xc0\xc0\xa0\xbd\x04\xc5\xfem8\xed\xd8Nd\xac\xac\x9c\x92\xdc\xc8D\x14)'\xbc\x88\xce\xdc2j\x9c\\q\xd4Z\xbb5\xdf\x0e\xb5\x11{\xa8\xff\x94\x99g\xec4z\xab\x12D(\x10\xc4?\xa1\xe2\xf3\x927\xbe\xdd\x88\xc6r\xaeK\xb1\xe0t\xb5[U|\xbd/o;\xa4\xb2\xdc\xec\xff\xca\xa1bCW\xdc`T\xff\xd7\x93\x8a\x89\x05w\xef\x97\xef\xfd\xeeq\xcaR\\e%Y\x86.H\xd74)i`\xbb\x16[f\x80F\x1e\x8c\x87\xb18\xc0\x1a(\x00\x92\xb9~{\x1c\xa6\x88c\xfb\xad@m\xdcd\x8e\xa7\xe7\x0e\x8e\x83\xaeW\x90\xe5V\xd8t\xaf\xbd\x85c\xb7\xc8\x87dx^N\x8e#\xec\x82]\xf6\x8c`}>\x85H\x93\xfe::\x8fF\xa9\xab\xfa\xf7\xcc\xbfe\x10G1V\x1fD\xaa\xb6\xe5U\"\x1c\xd5\x97\xe6\xf2\xb6\x97\xd0\x04\x8f\xba\xfb\x1d2\x1b\x95\xf4\x85\x89HS\x18\xb47\x97\xfb\xad\xe3le\xa4mn\x06$\xfc/\xd5\xb7\"\x14y\x95\xa4\xb9pfZ\xf6\x0e\x85\x9c\xb4\x87\x1f)w1\x13\x99\xa2\x97\xd7o\xde\xe25\xa5\x95b\x1a>NV\xaf\xcf\x85\xa7\x12f\xbe\xa0s3%0?\x8ao\xc2g\xf2a\xdf\xd9\xc0\xdc\x8dAl_\xd6\xa7\x03\"\xb4\x1a\xf2\xad\xe8\xac\x9f\xb8\x03\x9d\xfa,[l\x1c\x99V\xb9x\xfd\xbc\xad\xd6\xd5\x959\xfcA\x811Yo\xf4\xb1\x83	\xb7\xffP\x92A\x04\xc0\x1dT5\xab\x94\xf3\x1a\x8asg[\x9a\xa16b\xaa\xd4\x0eu!+b\x1e\x9a\x84\xf95:-\x0f4Cz\x9b\x7foB\xa5_\x9b0'K\xfb\xaap	]\x07\xb7\xc9\x9ao\xdb\xac6\xfb\xf4\xc3\x03&\x81p\xceq6M\x9fR\xb7\xa5\x9b\x9b\xed\xf4uC\xa3\x80\x99\xf7~\xa3\xdb\xc9S\x9b\x14*\xe8\xb5\"\xd9P@\x9a\x19\\E\xd76\xcb\x0e\xa1\x92\x0bo4\xe7j\xee\x917y2\xdb\xec\xda\xac\xa7xH\xd1!\xdd\xc8\x17L\xd1^m\x92Y\xfb\x17\xcd1L\xc4\x1cT\xa6M\xb4d\x97n=L\x89\x98@\xb1\x17\xa7\xdae\xb5\x02\x96\xb6\xdb\xf0\xd5\xcf\xc8\x0c>\xd0\x0d\xa0O(\xde!\xde\x10\xb9F\x07m\xa3s\xf8W\xa3\xddfl\xb3TP\x11\xe7\xe1\xc8\xc5\xf4\xb3\xb3\xd3\x81M\x87\x99\x03}\xe2M\xf0\x91Y\xf6\xd2\x0c\xfb\x18N\xa3\x04<\xa8\xfb\xd3\xe9\xe9=\x191[\xad\xc7\xd7\xee\x95\xf6\xd3\x8b\xca\x08\xa0Fn\x8a\xa6nDsL\x8c\xb9\xb9	\xf7o\x1c\x1e\xc2\xca\xf7\xacA\x1bx\xd3\xe6\xb79\x02Gww\x01t\x1e\x82\x13)\xd9q\x91\x89\x87\x89\x85\xe67?\xeb\x92p\xf7\x12\xd8c\xf29B(\x18\xef\xa3\x0c0\x15uW\xd2\xe4G\xec\xa8k!j\x80\xddd\xef\x97\xb4S\x8c\xd4='\x89\xdeO\xfejm\xd3+\xbc\n\xce\xa5\x8b'\xc2\x02T\x1b\xb1\x1f\xcc\xfdD\x03	c)\x19.##K\x00\x93\x8dM\xa3h\x8f\xb7\xe3\xd7z-2\xfd-\xdd\xf4\xf4\x9b\x8cIPu\xf4\xaf\xbfDh\x8b$\xc1\x10p\xf6i,\xea\xdfz\xc0\"\xc6}\xf9\xbd\xe7H'5V?zf\x8f$\xdd\xa7\xbc\x1e\x86\xe7Z \xe8\xb9\xa1f\xd2o\xc5\xa4\xbd\xc4si\xbe\x138\xd8\xb3\xca\xdc\xc2\xa6\xf7\x91\x02D\x81\xe1$\x1e&c	\xadN\xd6\xd2\x18%\xe8y(~z\x99g*o\xfd\xa6&@\x8d\xdc\xc4\xe0\xd1\x1f\x95\xa7\xa8\xe5\xd3Y\x14\xfb!\xd5\xdbg\x89\x1dzF\xdd\xdb\xb6\xaa{\xef\xc8\x1b\x81psm\x00\xf2\x9d\x8a\xf3 E\xc9\xa5k\xffN\xbfI\xde\x8b\xab=\x17R\x81D\x88\x05\x9d\xb98\xc3\x11vH(\xd8\x84&zkV\x82\xd2qP\x83\xbf\xb3\xc6#2\xc0\xc9V\xf5)\xe2\xd7\xfcn\xc1\xf0\x85I\xe1\xb1\x1c\xdfG<\x16\xfb}>\xe7\x8cZ\x8a\xc4zy/UU\xd4UV\xe2ZX\xcd3\x01\x0e\xc7\x1a\x02>\xd9L\xbe\xdeG\xdc0,\xd4r_\xd6\xefq\xa3\x02\xdb\x12\x0eUg\xbfn\x1f\x7f\x84\xaa>\xb8\x85\x8b\xaf)\x85{\x14\x80\x06\x8d\x8f\x9c\\A\xba\x82\xdd\xe7!+\xba\x86~\xb2\x14\xda\xf5\x03\xf6\xd8\xa8\x88\x1f\x15\x1b\xf0\xfd\x1c\xb3\xb3\xc6\x8f;0\xcc6\xfdo8l\xc6\xa7x\x91,q5%p\xf2>\x94\xd5\xc2\x94\xe5\xe48\x85\x8b\x01#&*\x86\x06\x8a\xfb\x18\xa2%\xf1$$\x9a\xef\xa2_BE\xb0\x7f\xe6m\xef\xa0AR\xe6j:\x19\xdb^4#[\xfanDh\xcah\xa8\\\x1f\xf7$\xc6\xd0\x9dX;%\x0b\x9c\xbeJ\x8b\xa1\xf0\xdf(\x7fy\xb3\xcds\xe0Xv\x85wh\x98\x99A\x13ics\xa7\xf9Hv\xbd\xaf]\x19\xf8\x89\xc9>.22\xfaK\x1b\xca\xfd\xd5\xa7_\xdf\xf7\xe8\xcd\xf0\xa6G\xbd\xac\x95 \x85Bu\x14D\x84\x00\xfe'TG\"G\x92\x0b\xe6\xac5\x15\xedE\x8e\x0b1\xfe \x89\xe3\xa5\xd7x!\x08\xbb\\\xfd\x902^W\xc5\x1bSqT\xa9\xf1\x0b\xd7\x11Q\xe5\xe6\xcax\xb7t\xbd\xf9^B~p\xe0gI]\x1c\xf2$1\xe4\xae\xee\x00\xccZ\xe4\x95\x99\x19j\x8aV\x04j\xd8<\xe3%7Y\x12\x15\xb2\xf1\x1fg\xc6\xce\xcf\x1e\xf0A\xdc\x08-\xf3\x15Za\xfd\xce\xdbCA\xe5\x8d\xcd\xe7\xbe\xfdb\xbd\x17oO\xd1\xb9#.\x81\xefE{\x8dE\x0b@P\xb7ZR\xdb\x1bh\x82\x95\xed\xdb\xd1m\x1c\x08\xa1\xbf;\xaf\xae	\xe9tD+\x18\x1f-\x90\x0b\xcb\x18\x01\x1c}\x94Z\xb6\\\x9e\xc6\xdf\xb6\xb2\xc1\xee\x84!\x14\xeaO\xc1\xba\x7f\xf4\x1a\x0fy(\x1b\x18\x1a\x1a[i\xb2\xfb\x11\xe8T\x1bd\xcd\xf8\xa5\xc4\x81\x89\x89U\xbdo&C\x11s\xc9\xb7\xec~\xd7J\xd1\x10\xa6\xb5\x9f\xb0+\xe1\xd4\x1f\x8fd\xb52\xb1\xb3\xaa\xa3\xa8J`eWv\x83H\xa1\xe7\xa4\x99\x905\xe3	w\\~\x86s\xb1\x93\xb1\xaf7(\xd7\xa7(E\xba3\xe3W=\x16\xd13\xcb\x06\xbd\xa9\xa7\x17\xe00t\\Ci\xeb\x1bMm8v\x94Ul\x10j\\\x9a\xb7\xbch\xc8\xf7\xdf\xec\xf6\x85\x0f\x93\xbboM\x96|&b\xd3\xa5\xdb\xcc\x82\x90\x0b\x86\xabw\xa2\xc7eE\xe2\xf5\"\xe3\xd2\xf1\"\xe4\xc6#\x07\x13\xf6\x0e\xe3T-\x7fF\xcf\x02\x16F_\xef\xf6\x83\x0b\xa1?\x85l\xc2<1p\xdb\x7f\x7fg\xf0\xe5\x82\xe1\x0f\x06\x7f\xdc\x1f\xfe\xca\x05\xf7\x08\xdbe\xedV\xd5\xed\\\xaf\xbfU\xe8\xb8\x13\xe8HP\xa6\x12\x08\x1e\x88(cl\x05\x10\x8b\x1c\x90\x909f\xb1YD\x90\xc1 \xa8\x08;\x1bKj&w\xce\xe3\x8d;uMx\xb6\xe2\xa9<\xf8ox\xad!\x1e*>\xb2\xa3%\xae\x0fb%\xe1\xae\x19R\x8c\xdd<to[\xab\n\x00i\x84\xb5W,\xa0x1\xb5\xd58\n\x1b\x91\xc2\x0f\xc4~\x84d\x8fh(\x13\xef\xd3}\xa6\x87\xd5\xd6\xd9I1\x1b\x03\xd4\x0eB)Z\xe4\xecf4\x8dr\xe9\xb5\xa2'\x15\xd60^6a9)\x91\x1a\x1a\x117\xe8\xd3.\xbb\x8e\"f\xbbV`\x06\x06i\xdb\x8d\x8aD^\xff\xdb\x92\x894~\xf5_\xdb\x98S\x0b\xf32\x7f\x1b\xfdyB\x15\x05\x90Y\x7f\xa0\x9b\xc6\xb2\xc5\x86+\xe5EI0$?\xf3[\xa5\xfc}\xfa\x0e/\xa8\x83n\xfeV\xda\x10ZI\x10R\x83\x1f h\xcen\x07\xcb\x83\xc8\x9b\x12\x828\xceO\xbe\xbf\xa1\xc4\x92\x9d\xfb&b\xe5\xa1\x1be\x1f\xf8\xf1d\xd3{\xca\\?\xb8G:\x88>\x06\xc3\x82`w\xc3\xfb<\xc5\xb4\xa8\x1f\xed\xe4\xc4\x02\xe9k\x0e\xafi\xabK0\xaa\x86\xf2\xfa\\\x81\xcf\x99\xd5\xee\xcf0lA\xd9`D\xaa\x07\xa3*n {\xc3\x05\x04v~<\xac\xfe\x9e\xb7\xbb\xb7\x01\x90\xe2\xf9\x120\xda\xca\xa8+A\xf7v(e2;\x063\x8f\xf4\xab\xd1m\xcdU\xa1\x96a\xe2k\xb8\x8es\x05\xed\xe1i\xb0d\xfa\x88;\xd5\xb6\x84\xfa\x97J\xed\xd7d\xfd\xba-\xaf\x86I7y\x19cM~\xbfZQ]q\xe2Vp5\x1f\x11\xed\xb9\xf7V\xf5Ii\xb2\x91&\x19\x98\x99\xcd\x10\x91\xd43\xd0J*\xc5L\x1dE\x15\x9f\x87{\x18\xa3;Yf\"\xd4g\x19\x8d4F\xcc\x11\xb2\xb0\xf2\xd1\xdb\xff2bM\x0f\";\xf6Q\x11\x9f\x9b5\x82\xa2\xfd\xe7\xab{\xbf\x1c\x85\xc7\x0e\xf6h\xdb\x8e\x92l\xf0\xde]B\xb0\xb6\x96\xbd\x90\xa4\xad\x9b\x86\x9d\x1f\xbcK\xf1\xf99\xb3OzQXC\x04\xfa\xf1\xf9\x00\x87\xe8L[\xc0?\xac\xf5\x17XT\x9f\x00\x15\xef\x87\xbe\x1f,\xf5i\xc5\xa0\xa8\xc5|\xbf\x0cz\x18@|\xf6\x95\xd0\xfb\xe3\x13\xa6\x91\xc7K\xfcw\x93\x08\xf6?\x7f_\xbb\xa9%s\xc9\x12\xc6\x1b\x1a\xe8\xd9\xf81\x98Uw\xcd\xed*\xe6\xc9 \xac\x82\x0da\xf2\xf71\xe9\xf8\x1f~\xb0\xf4\xe0N\x07y\xa8C\xd1Z\\\xb1c9\xff<\xbf\xf7\xf7\x94\x0c\xe5\xa2\xa5\xdd\x81\xa2\x8a\xae}~\xf4\x91\xceT\x96\"\xf5\x17\xb0\x11^\xdf0y\xbd\x1bq2[\xcd\x08W\xf6H}\x15\xa8\xb2w\x8a\xa3\xc0\"\xe9D\xea\x9f~c\x86`\x1e\xdc\x17\xdf\x11C\xa0\xb71ag9\x8a\xc1h}\x9dH;\xbb\xec\xb6\xa2\xaf\xe9\"\xdan\xae\x98\xce\xdc`\xba\xfek\x98\x93\xd4\x19b#D\x03\x053\xb25	ZHH\x16c}\xf8\xca.h\xb4\xcc\xfa\xca\xed\x9f3PZ\x92d\x1fg1%D\x02\"pARF\xbcG\xea\xbd\xc4pg\xab\xe9r[\x98j\xd8\x1eC`\xcb\xe3\x17sG:l\x0b\xe8M\xeb\xcdF\xd6Z\xd7\xfc\xb6RH\x146\xf4\x9b=\xb75\xef\x19gs\xe5\xe3\x1e\x0c\xcc.\xa8\x8c\xbc\xd7\xc4\xc0#7\xa8\xac56\x135Z\xdfz\x14\x11\x03dy\x03\xb9\xd8\n\xfe{&\xd8\xdc\xa5\x80\x00B\x9f\x95\xebF\xb7\x9c\xf4}H\xd7b`M\xab\xd9\x81\x06\xe4S\xd9c\x18}\xba\xa9p(\xc4M\xb3\xd1%EA\x11G\xb9\x9c_^\xf9\x9d\xe2\xda\xc2\x96\x12\xdf#\xa8\x089Y\xb4\x98q`)n\xfa\xad>\xda\xe1\x97_i\xd4\xa2	5\xac\x970jq\xab\xd2\xfd\xd4<\xb2\xb6c\xb3\xc4\xcc\xc9\xf8X\x19\x83\xa5g\xef\xd7\xbd\xf2\xf3\xa7L\xdf4\x18\xb2\xd2c\xf3\x85\xa3\xb3\xb3\xc0\xd6\x1a\xfa\x16zqI\x00\x80j\x91\xe2*\xc6\xf7\xde\x10cN\x18\xa0.\x17\xe9\x98\xe2\x95\xb8\x8a\xa1\xc7\x9ew\xb3\\=9'\xd5\x08\xec5\x98\x85\x17\x17\xd8\x16\x90\x05Ut\x9e\xfd\x19\xb1\x03\n\xb1q@[rRR,*\x0d\xbbt\xff\xf0w\xe2\xa8?\"\x93\x93~6\xe6v|\xfc{\xd6H\xf71\xa8\xef\xf8\xd8\xf6\xeff\x8b%|\xc1\xd7\xdf\xc7\x89\x96\x80FPa*\xfe\x8b\x11\x80\x81\xdb\xfed\x1a\x11\x8f S\x10E~\x8eJU\xdbBY\xcf\x9c\xf2\x11\x0d\xd1Nh\xdc\x0fp\xc3\xdf\x13\x85\xdd\xb13A\xca\xff\xf4\xec\x1dS\xdf\xd8\xd8\xfb3\x89\xe65\xe3\x81\xe8U~\nByc\xc7S\xe5\xb6z\xa2k\xaa\x86_\xf3\xf4\x14\x19\x19\xb9\xbb\xb7\xdd\xfc\xf1\xdc\x1f\xabH\xac\xf8\xbd;\xe2B~\x8c\xa3\xdbr\xbdE\xbc\xc6\x86\xa7\x96\xdc\xd8\x08Y\xc6\xdb\xf5\xb0L$\xa3\xcf#\x81=oE\x8d\xadM\ne\xdb\x80W\xdb\x1c$\x8b\xc0\x90	\xac\x1b\x99\x04\xdcK\x83V\x9c\x0c\xde\x84\xda>V'\xf3\xde\x08$\xc1\x0d'\x97\x9e\x97\x8c\xd9\x1b\xe56\xd3\xdeM\x18+\xebW\x9b\xb9\x12\x19S1F\x8d\x0d\xbd\x16\xe2H\xb5\xcb\n\x92\xb1\xdcaT\x8b\xfe\xa8\xcf\x9a+t\xe6\xa4BI\xeb\x9e\x83\xb7\xdd- \xd8\xd5B\xdbQ\xa8\xe4\xf7UZ\xb4\xa08\x0b9:\x01\xedCy\xa1\xa7\x0d\xd3\xf4\xf3E\xbe\x8a\x98\xc3B\xeb\x89\xdc\x11\xdf\xec\xf5\xd9\xe5Y\x1a\xe9\xdb\xd3\xaf-\x86~G_\x16q\x8e7Zw\xbbV\x12[j\xf4\xf4\xc2\x08L\xed\xe5\xc7\x99\xaa\xba\xbb_@J1'\"\xf0wG\x88\x13\xb4&\x87\xae[\xa5\x7f\x8c\x88\xc8F\x15\xddhj\x85:\xbe}\xa3+\xacE\xef\xc1\xc5!\x8d\xca,\xfa\xd41\x17\xf9!u\x9a\x9b\xb0\xa62\x82h\xdahY\xda\xdf\xa2\xfcW\xa9l\xc3\xa4\xbb\xbds\x14[\xf2\xd2	B\x11M\xb6\xe1\xa5w\x97e\xfd\xce@,\xb0\xa6\xee\x94O!\x90\x0fK\x8c\x98\x0d\x17\xf4DN.geg'\xfdxi\x86\xad\xe8\xed\xe6\xefusF\xbe\xd6\xb5\x81\xd0\x8d\xd3\xf8B\x1a$\xb7\x83Bc\xd0\x81a=\x1a/0\xf7\x8ae\x80\x97\xfe\xb4\x95\xeb\x00\x03\x00\x00\x00YI%\x89j1\xe3\xa0\xff/\x00\x00\xff\xffPK\x07\x08\xd2\xd9\x15o\xf1\x1c\x00\x00\x9d\x1d\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x0e\x00	\x00volume-off.pngUT\x05\x00\x01\x80Cm8\x00\xbc\x03C\xfc\x89PNG\x0d\n\x1a\n\x00\x00\x00\x0dIHDR\x00\x00\x000\x00\x00\x000\x08\x06\x00\x00\x00W\x02\xf9\x87\x00\x00\x03\x83IDAThC\xed\x98;h\x14Q\x14\x86\xffs\x9d\x8d\x98(\xa2`\n\xc5\x07XXX\x88\xa8\x88b\xb1\x82$;\xf7^V\"\x8e\x82OP\x11A\x84(\x88\xa0H\x12\xedR\xdaXX	\x82\x8fXl\x9c\x99\x9d\xc6B\x0b\xc1B\x05\x0b\x0b+\x83Zhg\x116\x12\xd89r\xc3\x8cL\x92Mv7\xd9\xd1	\xect{\x1f\xb3\xdfw\xce}\x0ea\x89?\xb4\xc4\xf9\xd1\x16\xf8\xdf\x19lg\xa0\x9d\x81EF\xa0=\x84\x1a	\xa0\x94rs.\x97\x0bGGG\xbf5\xd2\xbe\x996\xa9f\xa0X,\xae\xafV\xabw\x00\x9c7PB\x88\xbc\xeb\xba\xaf\x9b\x01\xac\xd76\x15\x81\x9e\x9e\x9en\xcb\xb2\x86\x88\xe8R\x12\x80\x99\x87\xca\xe5\xf2`=\xa8f\xea[*\xa0\x94Z\x03`\x08\xc0\x95Z\x10\x99\x15p\x1cg\xe5\xc4\xc4\xc4\x103_\x9b/z\x99\x13\xb0m{\xb9\x10\xc2D\xfcF#i\xcf\x8c\x80\xe38\xcb*\x95\x8a\x01\xbf\xd5\x08x\xdc&U\x01\xa5\xd4.\x00;\x88hY-\xa8j\xb5\xfa=\x08\x82@J9@D\x0b\x9a\x88\xa9	(\xa5F\x00\x1c\x9d/\x9aa\x18\xf6\x05APJC@)u\x96\x88\xf6\x10\xd1}\xd7u?\xd5\xcb\xaa\x94\xf20\x11\xf53\xf3U\x92R\x0e\x13\xd1\xf5z\x9d\x12\x02\x83D4P\xaf}3\xab\x90\xd6\xfa'3w3\xf3g!\xc4	\xcf\xf3>\xcc\xf5~\xa5\x94\x03\xe0\x99\xa9g\xe61#\xf0\x95\x886\xd6\x03JS@)\xf5\x06\xc0\xfe\x88a,\x0c\xc3SA\x10\x98\xb2iO\x12>\xaaxHJ)\xae\x07o\xea\xd3\x14(\x14\n\xdb,\xcbz\xcc\xcc;#\x96\x1f\x00N\xfb\xbe\xff2f\xab\x01\xff\xdc\xf7\xfdc\x99\x100\x90Z\xebM\xcc\xfc\x04\xc0\xbe\x08\xfa\x173\x9f.\x97\xcb\xde\\\xf0f\x14eF\xc0@\xdb\xb6\xbdN\x08\xf1\x14\xc0\xc1H\xe27\x803\xf1\x98\x8f\xca\xa6\"o\xe0\xcd\xefL	\x18\xa0|>\xbf\xb2\xb3\xb3\xf3\x19\x11\xd95\x86\xf64\xf8L\n\x18\xa8h\xa34+\xcd\x91\x84\xc4,\xf8\x85\n\xa4\xbe\x91i\xadO2\xf3\xa3\x19\x19`\":\xe6y\xde\xf3d\xf9B\x86P\xaa\x02R\xca\x0bD\xf4 \x19\xf9\xc4&;K\"S\x02J)s\x0c\xbf\x97\x80\x1f\xf1}\xff\xb8\xd6\xfa\x053\xeb\xa8|\x9aDf\x04\xa4\x94\xd7\x89hx&\xbcYm\x1c\xc7YQ\xa9T^\x008\x14K\x008\xee\xfb\xfeH&\x04\x94R\xb7\x01\x98\xabg\xfc\x98\xc8\x9b\xa5\xf2\xef\xd3\xdb\xdb\xbb\xd6\xb2\xacQ\x00\x07\x92\x12\xa4\xb5\xfe\xca\xcc\xcd\x1c%Z>\x07\x94R_\x00l\x89\xc0f\xc1\xc7\x16Z\xeb\x0d\xcc\\\x02\xb0;*{o\x04\x86\x99\xb9\x99\xc3\\\x1a\x02o\x01\xec\x050'|,a\xdb\xf6V!\x84\xc9\xc4v\x00\xaf\xa6\xee\xc4\xff\xfb8](\x14\xb6X\x96\xb5\xda\xf3\xbc\x8f56\xafYE\xc5bqU\x18\x86\xbb\xc6\xc7\xc7\xdf\xfd\xbd\xd4K)w\x13\xd1\x0e\x005/4B\x88o\xae\xebf\xf3B\xd3\x88u\xb2M>\x9f\xb7\xba\xba\xba\xcc\x95\xf2f3}S\xbb\x915\x03\x91lk\x96\xb7\xe8kD\xdd9d\xfaeN \x961c2\xfa\x02\xd7?_02+\x10C\x9b\xb5:\x97\xcb\xdda\xe6\xcb\xb5D2/\x10C\xf7\xf5\xf5uONN\xde\x05p1)\xb2d\x04fl<f\x87=g\xca\x88\xe8\xa0\xe7y\xaf\x16:\xe7j\xf5k\xe9\xb7\xd1\xb9\xc0\xcc\xe7\xf5\x8e\x8e\x0e*\x95Jc\xad\x84\x9f\nJ\xab_\xf8\xaf\xdf\xd7\x16\xf8\xd7\x11\x9f\xf9\x7f\xed\x0c\xb43\xb0\xc8\x08\xb4\x87\xd0\"\x03\xb8\xe8\xee\x7f\x00\xe2pU\xeb\xd7'|X\x00\x00\x00\x00IEND\xaeB`\x82\x01\x00\x00\xff\xffPK\x07\x08`\xe3\x11\xce\xc6\x03\x00\x00\xbc\x03\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x0d\x00	\x00volume-on.pngUT\x05\x00\x01\x80Cm8\x00\xf4\x04\x0b\xfb\x89PNG\x0d\n\x1a\n\x00\x00\x00\x0dIHDR\x00\x00\x000\x00\x00\x000\x08\x06\x00\x00\x00W\x02\xf9\x87\x00\x00\x04\xbbIDAThC\xed\x98kh\\E\x14\xc7\xffg\xb3\x1f\xcc\x06\x14\x8d\x8fVl\xa5`\xeb\xe3\x83\x1a\x8a\x1aAa%\xe2\xb2s\xa6++\xa6\xb4\x82PQ\x90>\xb1J\xa9\x8a\x8f\xd6g\x11T*\xd6\xea\x17m\x04\x05]D\xc8\xce\xdc\x1b\xb0`@\x05\x8b\x85\x16\xd1\x0f\xbe\xa0`} \xf8\xf8`h]\xd8\x1d9\xd7\xd9p\xb3\xbaf\x9f\xd9\x04v\xbe$w\xef\xcc\x99\xf3;\xff33g.a\x897Z\xe2\xfe\xa3\x0f\xd0k\x05\xfb\n\xf4\x15h3\x02\xfd\x14j3\x80m\x0f\xef\xba\x02\xcc\xfc\x18\x80\x9b\x00\x1c\xb2\xd6N\xb4\xedq\x8d\x81\xae\x01(\xa5\x1e$\xa2'\x01$\xfd\x9c\xd3\xd6Z\x01i\xbae\xb3\xd9+\x92\xc9\xe4\xd5\xc5b\xf1\xed\xda\xc1\x1d\x07`\xe6\x07\x00\x88\xe3\x835\x93\xb5\x04\x90\xc9d\x96'\x93\xc9\x1f\x00\x90sn]\x10\x04&n\xb7c\x00J\xa9\x1dD\xf4\x04\x80\xb3\xea\x84\xb8%\x80\\.wA\xb9\\\xfe\xb9j\x93\x88\xd6\x18c\xbe\x99}nZ\xcf\x9a\x01\xcc\xbc\x19\x808~\xee<\xb6Z\x02\x10\x9b>8\xfb\xbd\xfd\xc3\x95JE\x87a\xf8\x97<\xb7\xac\x80R\xea\x1e\"\xda\x0b\xe0\xc2\x06\x83\xd00\x80\xd6:m\x8c\x99\x8e\xdbe\xe6\x97\x00l\xf7\xbf=b\xad}z\x0e\xc0\xf8\xf8\xf8\xc0\xe9\xd3\xa7/\xab\xe7L\xb1X\xfc\xd2Gc\x13\x11\xed\x01pq\x83\x8eW\xbb5\x04\xc0\xcc\xef\x00X\x0f\xe0nk\xed\xeb\xb19\x88\x99\x8f\x01\xb8\n\xc0\xb7333#\xd3\xd3\xd3\x7fF\n0\xb3\xd0\xec\xfc\x8f\x857;>\x95J\xa5\n\x85\xc2)f\xfe\x10@\xbaI\xe7\xa5{\xa3\x00\x05\x00\xb7\xcb\x00\xe7\xdch\x10\x04G\xaas)\xa5\xee'\xa2\xe7\xa3\xc8\x13m3\xc6\x1c\x10\xaa\xdd\x00\xf6\xcd\xe7\xd0\x02\x02\xdc\x0c\xe0\x03\xef\xe4g\xc6\x98k\xab\xbe\xe5\xf3\xf9\xe1R\xa9t\x1c\xc0E\x00\x8eXkG\x05\xe0+\x00k\x16\x0b\x80\xcf\x88\x17\x01\xdc'\xffW*\x95\x0da\x18JZE\x8d\x99\x0f\x00\xd8\xe2\x15\xbaR\x00\xdc|\xce\xcb\xfbn(\xa0\x94\xd2D\xb4\xcf9W\x08\x82@6\x84\xa8\xf9\xad\xf3#\x00\xab\x89\xc8\x18c\xd6\xc5\xd2H\xc6\x14\xbdB\xbb{\n\x10\x8b\xe6\xef>\xdf\xbf\x8e9*'\xf9\xb3\xf2\\.\x97WMMM\x9d\x88\xa9\xf0\x0b\x80\xf3d]\xf5\x14 \x97\xcb\x8d\x94\xcb\xe5\x8fE`\x00\xafXk\xb7\xc6\x00\xee \xa2\xb7|\xa4\xb7\x1bc^\x8e\x01X9\x1e\x88\xe8\xf3\x9e\x02\x88CZ\xeb=\xce\xb9\xc7\x89\xe8{c\xcc\xca\x18\xc0uD\xf4\xa9\x7f\x9e\x03\xc7\xcco\x00\xd8\x04\xe0d\xcf\x01\x94R\x1b\x89(*\xd2\x9cs\x97\x06A\x10\xa5Q&\x939'\x99L\xfe\xea\x01&\xad\xb5\xb7V\xe1\xb4\xd6\xcf9\xe7v\x018\xd5s\x00f\xbe\x0d\xc0{~\xc7\xb9$\x0c\xc3\xef\xfcns6\x80\xdf\x16=\x80RJ\xd2GN\xf6\x13\xd6\xdaU\xb1<_\x0b\xe0\xe8\xa2N\xa1l6;\x92H$\xaa\x8b\xf8\xa0\xb56\xda\xdf\xbd\x02\xe3\x00\xde\xf5\xa9\xb59\x08\x82W\x17\xdd\"ff\xc9\xfd\x8d\x00\xfe\x18\x18\x18\x18\x9d\x9c\x9c\x94C\xb5\n0[!T*\x95\x15a\x18\x9e\x8c\xbd\xfb\x11\xc0\xf2\x9eo\xa3Z\xeb[\x9cs\xaf\x11\xd1\x841F\xd2(j\xf9|\xfe\xfcR\xa9$\x07\x99T\x08\xd6Z\xabc\x0b8\xed\x9c\x93zL\xdaVY\xc4\xb2\xeaWW;\xd4\xfb\xdb\x8d\x93\xb8\xde\\\xcc\xfc\x82/.\xa5h\xdb`\x8c\x89\x97\x12\xb3\xef\x12\x89\xc4\xe5\xe4\xef\xae\xd1\x89\xf7\x7fm\xa1\x00\xb4\xd6c\xce\xb9\xc3\xde\x97\xa3\xd6\xdak\xaa~\x8d\x8d\x8d\x0d\x0f\x0e\x0e\x1es\xce\xad\x903\xc2\x18s}TN+\xa5\x9e!\")\xa7\xcf\xe8\xb5\x025\xe5\xfazk\xad\x94\xd7QSJ\xed$\"Q@\x94\x89N\xe7\xd9\x1bY:\x9dN\x0e\x0d\x0d\xd5\xbd\xd0Xk\xbf\x90\x81Z\xeb\xbb\x00\xec\x95(\xcc\xa7Z\xcd\xfb\x86\xee\x03Z\xeb7\x9dsw:\xe7\x1e\n\x82`N\x99\xcf\xccRJG\x17\x9aT*5R(\x14\xfe\xb9\xd0\xb4\xd2\x94R\xf7\xfa\xfd{Y\x83\xe3\x1b\x02\xf0A\xfa\xd7\x95Rk\xbd\xdf9\xb7C\xde;\xe7\x1e\x0d\x82\xe0\xa9H\x89\x06'\xaf\xdbMk\xbd\xcd9';\xc8\xf0<\xb6\x1a\x06\xa8\xb5\xc3\xccr\x17\x96;\xb1\xb4\xe3\xa9T\xeaF\x89~G\x00\xaa\x931\xb3\\@\xa4\xa6?\xb3\x0eHK\x00\xf1\xefBb\xb7R\xa9\xdc\x10\x86\xe1'\xd59\xdaV\xa0\xd6Y\xa5\xd4.\xff\xb5\xa2#\x1f\xb6\x94R\xcb\x88\xe8'?\xcf\x16k\xed\xc1\xf8\x9c\x1d\x07\x88)\xf2\xb0W\xa4\xedO\x8b\xcc\xbc\x96\x88V\x1ac\xde\xaf\x0dX\xd7\x00\xaa\x13\xf9bMN\xcf\x89 \x08\x0e\xb5\xbb\xe6\x16\x1c\xa0\xd3\x0e\xf7\x01\xba\x1d\xd1f\xedw}\x0d4\xebP\xb3\xfd\xfb\x00\xcdF\xac\xd3\xfd\xfb\nt:\xa2\xcd\xda[\xf2\n\xfc\x0d\xc5\x19Z\xee\xb02{\x99\x00\x00\x00\x00IEND\xaeB`\x82\x01\x00\x00\xff\xffPK\x07\x08\xbd.8\x7f\xfe\x04\x00\x00\xf4\x04\x00\x00PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xfb\xd2\x9a\xc8+ \x00\x00\xd2 \x00\x00\x15\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x00\x00\x00\x00gopher-acceralate.pngUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(GD\x92\xa4\xd6 \x00\x00w!\x00\x00\x10\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81w \x00\x00gopher-fly-1.pngUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x0b\x8e\x19h\xc7 \x00\x00h!\x00\x00\x10\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x94A\x00\x00gopher-fly-2.pngUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xd2\xd9\x15o\xf1\x1c\x00\x00\x9d\x1d\x00\x00\x11\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xa2b\x00\x00gopher-normal.pngUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(`\xe3\x11\xce\xc6\x03\x00\x00\xbc\x03\x00\x00\x0e\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xdb\x7f\x00\x00volume-off.pngUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xbd.8\x7f\xfe\x04\x00\x00\xf4\x04\x00\x00\x0d\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xe6\x83\x00\x00volume-on.pngUT\x05\x00\x01\x80Cm8PK\x05\x06\x00\x00\x00\x00\x06\x00\x06\x00\xab\x01\x00\x00(\x89\x00\x00\x00\x00"
+	data := "PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x11\x00	\x00achievements.jsonUT\x05\x00\x01\x80Cm8\x84\x8e1k\xc30\x10F\xe7\xfaW\x1c\x9a-\x90	]\xb2\x05\xeaT\xa1\x89\x94\xa6\xce\x10J\x07\x91\\\x9b\x03K-\xce\xc9K\xf1\x7f/\xa2\x06\xdb\x1d\xea\xf5>\xee\xbd\xf7\x9a\xdd}\x0b\xba\x88%\x08\xff\x19\x03;\n7Y(\x91\x83`\xe2\x1a\xd3\xb0\xb3GS\xad6\x06\xb4\xdd\xef\xcbC\xda\xb0\xc5\xc0i\xab]\xb8\xa4\xc3\x8d\x1dO \xe9\xe8)\x88%\x14\xaa\xcb\x07\xcb\x15\xe9\xe3\xca\xf2^\xa9\xa9\xe4\xe5\xe9\x04z\xf3\xa8\xff\xa5\xff>\x0f\xe8D\x19\xc3\xbf\xb0y\xc73\xcb\xe2/|gm\xa5a\xbb2\x0f3\xfd=a\\\xafr\x10\xe7\xe8c\xed\x98\xda\xd4\xcaM\xc4\xb1\xd5Q\xc3\xe4Q.\xa6\xd2\xf5\xa1|>\x96\xa6\x82\xf5\xf64c\xed	\x83u\xa1\xba\xec-\xfb\x19\x00PK\x07\x08\xa5\xc3\xbc\x15\xc6\x00\x00\x00\x9d\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\n\x00	\x00atlas.jsonUT\x05\x00\x01\x80Cm8\xac\xd2\xdbn\x84 \x10\x06\xe0{\x9fb\xc2\xf5\x9a\xec \xd4\xc3\xdb\x10\xc5C\xa2\xd0\xb0\xd2\xed\xa6\xf1\xdd\x9b\xb1	\x0d\xda\x90\xb4]\xef\x86\xd1\x0f\xfc\x99\x8f\x0c\x80\xdd\xa7n\x1dY\x03x\xe5\xe2B\x0b\xa3\x9e\x86qe\x0dH\xfcZ\x98\x165\xe8\x1bk\x80\xde\x07`\x83}\x1d\xb5\xcbU\xdb\xeaY;\xb5\xea\xd0\x02`\xef\xac\x81\x17!\xe9Cz\xd8\x83\xe8P\xdd\xf7n(i_Q\xed\xd5v\x89\xf0~~\xe4xtK\xc4g\xb8\xfc\xe4\x96\xe5\x7f\xcfk\xac[\xd4|\x84+Q\xfc9\x88\xc5z\xb3\xaa\xc9\x1c\xcdT\x04\x12y\xe8R\xb6\x12yd\xde\xbc\xebU{\xba\xaf:\xf9\xff\xdf[\x10\x89\x11\xe8M\xa7\xdd\xe0\xac7\xdd\x11\x95\x98\x1a\x02\xe4U\xe8\xee,\x8f\xc7\xe0\xcd\xce~\xd1\xb9\xed\xfb\xd3a\xafu\xc2-\xe2\x00\n\xfe\xa3z\n\xb5\x16\xc5\xef\xd1\x0c`\xcb\xb6\xecs\x00PK\x07\x08n\\\x06\x89\xe4\x00\x00\x00F\x03\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00	\x00	\x00atlas.pngUT\x05\x00\x01\x80Cm8\xec\xb9eX\x94\xef\xbb\xf7{\xcd\xd05\x80  9\x08\"\xdd\xc2H\xb7(\x9d\nH\x83H\x83\xa4\xe4 \xc8\xa0\xb4tw\x974\xd20\x84\xd2H\x87\xa4H\x97\xa4\xe4>\xfe{\xedg=\xffu\xec\xe3\xd8k\xbf\xfc\xad\xe7\xf0\xfb\xe6\xbc\xbf\xf3f\xee\xb9\xbes\x9f\xd7y_\x9f\x0f\xea\xaaO\x88\xf0\xa9\xf1\x01\x00DO\x15\xe54\x01\xc0\x04\x00@\xa1\xb8\xd8\x00\x00\x0d\xac\xb6*\x00,k\x9f\xcaIk{\xa6\xee\x18\x128i\xeb$\xdf\x0c\xb5\xfcP\xe9\x94\x95\xb9\x13{\xa0\x18\x82\x96%\x84|\xfa\xcaM\xfa.\x89$\xec\xa7\x82P\xbc`\x80\x0c'a$n\x13\xcd\x93\x91\x19.\x8f\x8frk\x8f\x1e\x05\xefp\xf2\xb3\x8a\x12\x88l\x13\x92\x07h`\x8bp\x0e\x8c3\x8b\x0e\xc9lbRu\x9fO\xeb\xf3\"EB>r\xfc\xee[]\xa8>\x11^\xefJ\xc9\xc7\x08P\x81\x07\xdd^\xbe\x19\xf35.\xd8\xbf\xder\xd5\xf1_|\xfb\x12\x83\x10;\xb0\xc3\x84\x97\x8a\x10;\xb0\xc3\x84\xb7Q\x0c\x01\x96\x00\x12\x03\xc0\xc0\x7f\xb9Xz\x06A>\xbd]\x92\xe5\xc7\xf4\xc3\x81\xe7u\x9f*\xf9\x1b\xc0\xde\x07XA\xba\x82\xed\xac\x8c\xfa:@\xfa\xceT\xc5b\xf0\xf8\xf8\xf8\x14(\x05\xbcO\xf7\x07\x06\x06\x8e\x01\x89WKh\x87\xaf\xf1\"\xc6\xe7\\\xff[\xbf\x8a\xdc\xdc\xdc\xab;\xed\x91\x10\xe5VyW\x13\xfe$e\x96\x98o\x16\xfcI\x03\xaa\xb2\x15U\x97\x14\x00\x8f,e\xdc]\xa0+\xf6\xed\xbb\xfc\x81.B\xb7\xf9\xb6\x9a\xe9!\xe6\x8f\xdf\xbfk\xd2\x85prsp\xc4\xa0\x88\x19\xe2\xad\x86R\xa3\xd7\x873\x12\x1e\xaa\xa5\xc76y\xec\xc7\x8f\xe5\xab\x88.	\xb5\x1b\xf2\xca9o\x1f\x8coM\x94\xf0\x8c\x9a\x0e\xa7\x8b\xff6\xbdE\xfbcG\x8d\xbdw\xfb\xf7\xaf\xe9\x0eU\xe0\x82~\xb0\xd8yO\xe9\xff]T\x06\x8a\x0c\xed\x957\x03H\x96\xe5\x0e\xe3(<\xb5\xc4\xd5F|\"\x9b\xa4x\xcdd\xcd>\x13\x92\xfby\x82\xc5\xc5\xc5b\xc5\xdd\"\x82pg\x14#\x93\xb0\xdd4\xfc\xee\xd0S6\x9aqM\x9e<\xbb\x1a{\x03\x87\x85f=\xe8\x8b\xa2\x08\xe2\xa9\xd5l\x81m\xf8l!\xba0\x9cF8x,\x8bQ\x0e\xc5\xb0\x88)\xf2\xbf\x96\x93\x8aP!\xa1\x13\x87\x17\xce\x94\xc3\xc7\xab\x1cZ\xfa\xdaH\xf8\x90\xfd\x8f\x94\x00\xf4\xbd\x8dq\x90\xd7.\xfbqp)J\xde\xda\xba\xa8\xd7\xd2\xfdG\x8f\xc7@<\x97\xc1\xd7c\xa1\x89\xa9)Q\x8b\xf3\x93\xef\xe3\xe3\xcc:$&\x0c\x08O\xe4\xc0~IZ\x03\xd6\xd5m\x89\xac\x0d\x8d\xebk\x11\xcf\x03\xf8\xe6\xc1~\xda\x0b\x9c\xffX\xc0\x7f\xfd\xc0\x1d\xfec\xd6\xb9\x13\xc2{\xdeLw$\xd5\xe7|\xb7t\xc6V\xb7\xb8\x96\xa42%\xee\xc3\xf0l\x8c\x85O\xbc\xaa\xb5R\x9e\xe3\x8b\xd1G|\x8c\x98U\xd7\xd6>\xee}\xa0\x14?.v:m\xde\x86\xf3\x19&\x05AR\xb9\xa5N~\x88\xa0\x1cW\x92\xb2\xf7\xbe\x1e/\xd2\xb28\x1df\xab\x9eS\xe6o\x8fX\x9d\xb6@\x12\xd3\x89\xc8\xfd:\xb3\xeaS\xa4)N\x1d\xca\xc50\x8c\x91\x14a\xb9\x88\xfb#\x7ft\xc6e\xd4|\xde\x9e\xfa\xd8\xa5\x18\xd2Lh\x92o\xcb\x1d2\xe9z\xaeB\xe3\xda\x90\xe9\xeaYD\xb6\xd5n\xf1\x9f\xcbo\xf8\x91\xb2\xc9\xb07[\x123\xd9d\xa9\xbf#\x90t\xf4Y\x82]\xc5\x85\xa4(\xc2\xa2\x1d\x15i\x86\xcaI\x98\x9d\xdae\x11v\xb80zHAN>\xbe\xf7\xa3I\x18\xe1`H\xa3\xbb\xb29V\xb0vs}\xb9\xd6\x17\xc7\xd1\x06\xde\x1d\xc1\x99\x83\x12_\x19*d\xa0\xe8\xbd6\x0ef\xaa\xad6k\x08,\xfc\xe8zG\xbb1\x17\xe7\xeaTh\xa8\x8b\x8b\x8bY1Y\x1d\x1e\xb8~\x89\x16\xb1\xa0 '\xf7qzL\xa7[\xfa\xe2\xf0.\x92\x12\xc2\xec\xe7\xe1E@\x97}tt$\xd9\x8aO\xa7O\x90\xf1\x9f\x19a\x07\x8e\xaa\x1e\xb0o\xeb\xca\xf4,\x84\x08\xf6q\x9bw)O\x085k\xbf\x07\x0c\n\xb0\xd7\xf4B&B	n.uW\xebm\xe7+\xa1\xb3\x19B\x9f\xac\xdd\xc2\x0d\x1c\xd5\xb7k\x0c\xfdn\xfe\xac\xbf\xe9\xfexw\x1d\x12\x1b\xb4Q\xd8\xdfh\xbe\xd2\xf5\xa1\xf6\xa1j\xea\xeb\xbd=\xd5\xb2\x05\xea>\\\x9c\x94\xee\xfe\xfe~6LE\xa2/\xf14\xd2\xd73c\x93\xe5\x86sBt\xaa>\xaa\x01_\xb1\xc0\xf0\x17(\xbcB?\xc4\xf9\xde\xbd{\x0cF\xefag\x0d}&\xeef\x12\x07\xed\x18\x81\\\xfd\xb3\xaf\xf5\xe2\x07\x06\x07\xb9&\xe6*\x84\x7f\xff\xfe\x1d\xac\x1f\x7f\xf2\xd1R\xcf\xe0X\xe8\x7f\xdf\xb1\x88k\x82=\x06t_V*\xb9\x9e\xb1>\x8e\xeffq\x10\xc7d\xe9	9\xb4\xbd\xfdq\xb5\xe8\xefcH_\xb3[P\xf4\x89+\x92o\xfcl\xcf\xb0\xd9\xf3PcMiX\xab\xbff+\x7f\xa1\xb9\x90o'\x82\xbf\xcdbk\xedr\xbf\x8d\xe7\xa1J\xf21\x0c\x19]\xde;\xbb\xb9\x14\xe4%\xdf\xdc\xea\xb8(\xa1SZ\xeaQ\xff\x8e\x83\xe9\xe5\xe2\xc8\x08\xbc\xba\xba\x9a8\xe8\xb5O\x8e\xebF\x9d\xc5\xb7O\xabe\x9b\x99\xbd\x87\xa81F[\xcdIo\xc1?\x0cwW\xce.U\x12`\xd1\xc0\xbdF\x03\xe5\xe1\xf0\x19&\xccT^\x02sX\xadH\xa0!\x05/\xe2\x1f\xf1\x0b\x0b{T_\x8a\x1c\xb7\xf6wT\x95\xd6\xc6[\xee\x0e\x0e\x0d\xed\x95\xd5\xbbaQq=OR\xfe\x8f\x7f\xf0\x80\xee&+\xd9r{\xa8u\x14\xd2\x17[\xb4\xa5\xde\\\x10I\xd0\xf7\xd5f\x83a\x06\x9b\x963\x908\xb2]:\xd7\x17&j\xb2R\x1b\xb2\xd0@&\xc5$\xce%u\xcdrR\x9c\x0e\xf1\xb2\xab7*\xa7\xc8g\xaf>\xa4\x1en\xbb#FJJ\x1a\xbd\xe5\xeb4\xe9-KE\"#\xc8\x13l\xda\xceKH\xbd\xba\xe7L\x8b\x1a(\x130\xeb\n	\xee3\x8c\xcb\x89\xe72p\xc7m\xcf\x99\x98\x98\x18/^,\x14,\xc19P\xf7\xf2\xba \xd6-\xd3_K\x15\xf5\x18\xb2J~\x95C\xd2\xe9n\x87\xea{g?'Q1e`\xac\xab\x1b/3\xdc\xff<c\xbf%Z\xc45t\x83b4\x19\xf6,\xd0Id|\xbfx>\xae\xc6n\x86\x9f\xff RG[{\x1aP\xc7\xc2\xc6\xb5V:\x8e\x8f\x8f\x1f\x94\xe8\x96}z>bJ\x8edp\x87\xd29P\x18	\xd3\xd5\x1c\n\xab\x1c\x99\x03\x97\xe3\xd3m\xa2\xfe\xaa\xef\x9c\xfd^\x18\xed\x8c\x8cr\xd2_\xf78d\x9e\xbd\xbd#\xd58\xbc\xce15GE\xf8\x1f\xf1\x86\x19\x90\x1aY\xb2\xfd\xd6\xee\xbfy\xeb\xc8\xf9}\xbcudf\xe1=?C\xbb=\x154`\xb1\xc4%\x97\xd4\xb4y\xa7	\xe3\xdbE;/\xef\xf8\x9b\xdap\x83U\xc8|\xc5B\xfd\xc3\x13\xdf\xc1\x8a\xc2\xc2\xd7\x0ey,Lj\\\x13\x8dXb\xb4\xe3?\xdd;\x16\xc0\\\xbdc\x81\x93\xc5\x17\xf6\xd0\x9b\xe6\x8b\xc3\xb7 \x98\xf8\x19\x95\xf6\xc9\xaf\x14\xc7-\xad\"\x0e\xdf\x9cUD\xa3A\x0b'\x17\x978\xc6RD\x94-~\x81\xac\x08\xd2\xee\xa4\x95\xc9\x95\xb5Q\x81\x8e\xfa\xa5H\x03\x83\xcb\x86\xcb&\xef}	\x89\x1bIC\x91q\xd4\x96\xba\xa6\xe6\x1b\n\xa9\xb6([\xfc\x02\x8c	\x14\xed\xc9\x8dn\xee\xa4\xa9\xcc\x93-\x92\xf2nI\xd7/\xf4\xe2\x80\xb3\xbfq\xaa\xaa\xd4pY\xc2\x98\xe7b\x9a\xa3\xa3\xaa\x14)R\xf0y-=\xa5\x8d\xff?\x9b\xa2=V\xed\xfd\x0b\x13\xf3\x87\xca\xca\xa8\xc0\x90\x1e\xadB>ol\xabVG\xcdP\xb5$~\xfe\xb7\xe3}\xdb\xdd\xd6W\xc4\xccU&f,\xcc\x11\x08\xcaT,\xa2\x87\x87\xe5\x07+j\n\xcc7\x1d4\xe1\xce\xe2\x97Y<W\x831\xe8\xc76\xa3\xf9\xbc\xe4\"/3\x877\xcb#\xd2\xc4\xcc\xc7~I\x0e\xcd6\xda\xd7\xc3 \xda\x1e\x97\xa7&1\xc9\xbe7\xc6\xd7~\xb7\x03\x05\x19~\x17\xdb\xe6\xbd\x11X\xfbc\x82\x83,h^\xea \x92cEID\x84\xecp\xe7\xd4z\x87\xa0J\xa1\xeeA~\x91V\xc1\xec\xe5\xe9\xae\xb7\x82\n\x89b\xee\xa4\x9f\xac\xf5\xe9\xe8aNo[Ft4_}C\xc3\xb9\x9c\xa1!\xdd{.zF\xb9#\xcf?o\x8f~\x9d\x80whv\x197^Y\xd7iQ\xdb\xed\xba\xc5O,t\xb9\xe8~\xbf\xb6\xdb\xe8\x82t\xaf\xad\x94\xd5\xdeH[:\xea\xb9\xcc\xc0\xa9<\xb4d1\x1et\xb3BzS\xeaR\x12\xe1\xbe3\xed{\xef\xde\xbd\xa3\xd0\xd0P\xb5\xc9\x89\x89?2n/>\x9b@~l\x7f\xe7\x15i\xd8F_\x0b\xf0L\xa2nG~\xd5\xd3\xb9m\x94\x12\x16\xc8\x0e\x0d\xa8\xa4,\xa6\xd6\x9eLM\x95\xca\x1a\x19\x19\xbd22Zh\x1c\x91\xbf\xb7fks8<8H\x17\x1f\x13CV6%+\x9d\x05\xe5b\x9c\xe2\x13\x14\x16\xf6p\xde\xc8.\x0f\x84\xf6\xdcfG\xe0^\x9c\x97@\x92\xae\x9b\x9b\x9b\x8d7\xdc\xfa\x92\xc6\xe7f\xb6\xe2\xfe_\x1b\xfa\xff\xbd\xb3;|\x01\xa0x\xae\x8eR\x88]\xec\x87\x93$\xad\xe0\x8cU\xe5\xcc\x81\xa9\xf9\x19fW\xd1\xb5J_\x88\xa7\x0e\x89T\x05THH\xd6L\x91\x17\x17\x14[0\xed9\x0f\x99\xeb\xacNM{\x9c\x12\xf8\xb0\xd4-\xcb\xe2\xd2\x06\x05\xa8l\xdc\xf5\x89M\xd7\xce\x0f\xa6`\xb2\xef\x80:\xbe\xb2.\x12}\x999\xfc\xf8	\xe5\xa3\xce}\x1e\x9a\xc4O\x1f\x1b\xa2;\xb2N\xf5\x8e\xf7\xe7\x92\x04\xba\xd7\x1c\xf3v9\xb9\xb8\xdc\xc6\xc5\xed\xfd\x96\xdfS\x06333s\x1a7\xban\x16<z\xf4(\xfd\xe2\xc2\x8b\xbf(\x18\x1f\xe3\xeb\xf8\x9c\x97\xb2\xd8=S\xea\xfb\xf0\xbc\xdc\xbd\xdf\xa7\x0d\xce\xab\xd4\x11\xf4\xe2\x17g\xce\xce\xce\x06:\xa3\x8d\x1c\xcdE\xf4\x91\xf5\xa6\x83\xc9\x8fd\xc9\xc8\xc8\xfc\xab\xf6\xf2\xdc\x1e\xda\xcd\xdd\x08?\xba\xbc\x1e\x97\xf0\xf5\x8aPy\xfa\xb4(s;\xd3j\xa3\xc9\xf5s-\xcc\xe3\xb2\xe0\xd6\xc2d\xcbmt9\xc1\xd2\xd2Rveu\xf5y|l\xac\x8f\xae\x96\xd6j\x0b6\xdd\x8dP\xb9\xff|\x85\xceh\x1d~\xa2X\xb1p\xfb\xf0T\xa9\x9e\x003\x1f\x9f\xf3Ic\xc2dZ\x0d\x9dw\x0e54z\xb1\xda\xaa\xde\xf9l^ \x98\xc5\xf5(hc\xa9\x9c\x98\x87\xf0\xbc\\B9\xdb\x9f\xd5y\xb8w\xfa\x81\xd4\xc9\\s\xe7\x99\xccf\x94\xab\xca	#\x0f\xcf\xbe\x06\x9be\\\xec\xef\xc3(y\x9a]\n\x96k\xf6\xf3\xe4\xde\xd5?\xe8X6\xad\xbdI\x1a\xc17..G\xac\xda\xbc*\xd5}\xb5\xbb\x8e\xb9\xb1\xb7\\A\xac\x04z,\xc5\xb2\xad\x15F\x15q\xdb\xb9\xb1\xd5\x05\xcaI\x9f\xd48\xe2\xe2\xe2\x1e\xc9\x84\xd8\xc7&\xbc\x88\x8fCC\x00\x0e\xa0\x05N\xa0\x1d\x02p\x00-p\x02\xed\x10\x80\x03\xeam\x00\xb0\x1coV99\xe7\xba\xcfK\xd8nT\x00q\x19\xf2\x7f'\x92SP)v\x1f\x86\xd7\x17\xb2Qy\x13\xbe%\x86\x0f\xcd\xc5\x87V9\xd1\x04w\xb4\xafFM\xb3\xdb\xfb\xdc\xa7#\x84\xf0*\xb4'\x06\xb8t\xec\xcdQ%\xa6\xd3g\x85\x85W\xfb__\xf4l}\xd7\xa4\xe3>\xc6\xe1\xd5\xe5\"\xf0\xea9U)o\xb6}\xb1\xba\xf0\xfb\x0d3%\x05}\x96\xcb~*\xaft}H\x1d\xa5\x9d\x1bqA\xe1\x83\x9b?\xeb\x19\x0c|\xee;\xd3Izzz\x8e\xb1		\xb7\xfb\x06\x8e-k\x7f~\xa58\xben{j\xbeD\x84v\"D\xbf\xbe#\xa3N \x1c\xfd\x0ej\xec\xe3su\xfa\xc5e}\xaf\xa0w\xa9\xe8\xcd\xb7\xf3\xb9q\xafr/\x0c\xf5\xe7\xb7\x0c\xdc\xfa\x0d\x1b\xdc\x91\xbd\x02j\xb8\x87Z\x8c]\x11\xf4\xe2\xd960z\xb1s\x1c\x11\xf7\x8b\xe3\xcd:\x91H\xd7LS*s@~\x1a\x12Ng\xf8\xf8*\x1b\xf6\xf4\x19j\x0e\x87\x88_D\x11\xc5\x03\x11;\x9aw\x19\x8e\x86UW\xb1X^\xe777_\x1d\xcf9\xb6\xfdH\x86\x853\x8a\xec\xb0\xa6\x1a(\xc6\xd0\xf4\x14~#\xda\x14\xd9D\x14\xb9\x9e\xc7+\xdff\xbf\x0f\x0d\xf6VV\xcb\"1\xab%1\xf3\xde_\xa8t\x9c\x18\xbf\xfa\x92\x1c\xa1#2\xd2B\xa4\xee\xc68\xa8\xc3@\xad\x88\xfa\xf9\xc5\x85Xk\xd9\xd7\xb1\xad\xa5\xc5\xad\x814Sd\xd7\x99\xe6&j\xbf[\xea\xa4\x05N\xf7\xeb,lWTs\x9b\xb7\xea\xf3\xc1j\xc9\xb5\x91\"\xdb\xf3\x8d\xb3]<F9:$3\x9a\xb7\xaeA\x169??\xffA&D\xc5\xe6\xc4\xfe\xe4\xd9\x91R\xcd\xa5\xe7Ujjj\x03\x9bV\xfe\xbc\x02G\x9d\xfd-y\xfb\x94L\x88\xd5\xd1\xd1Q\xce\xeb\x94U\x84\xce\xb3\xc0\xe3\x88\x91%/\xad\x1a\x97\xb7\x84\xd8\x81\x1d&\xbcTa\x06\xc4\x12\x13^*\x93\xf6\xa4\xb4\x9c8$\x0f\x08\xa5O?\xeb\xe3\x1e\x8a2\xa6F\xee(\xeet\xb0\xa5\xea\xb6I\xbf\xdf\xca\x9e'\xd1\xa4\xe0\xcd|N(q]\xc7\xa3B(\x8c\x108\xcai\xdc\xda\"\xf0\xa2L\xc2\xa2\x9e\x920\xb9:\xff\xb0\x98\xc2\xcb\xf8EM\xc6\xec\x96\x9eoT\xdc\xab\xabk\xa5\x9f	5\xfa\x90QZ\xe1$\xe2M\xb1\x7f\xdd\xa4\xd8A\xe8s\xe3\xe7\x91\x8b\xa2?\xbd\xae\x8cr\xf8\xc5\xe9'\x1e\xe9\xa3\xeeLA\x05\xb8\xb8\x06\xa7\xdb\xfc\xdb\x9a\xc2^\x80q\xea\xa4\xa3.2\xb50\xb5\xa7O\xef	\xd9\x8c\xb3:\xfa\xf8\x88\x99\x99\x99}\x95\x1b\xe70\xafV\xc0%\x15\xc9i#\xe4Z\xcc\x88\xb5\xd0\x1d\x9f\x14\x1a\x9f\x9c\xbc\xf8\xf4\xe9\x13\xf5\xf8\xceG\x198\xc2\xa3\xd3\x17c\x8f\xce\x7f1\xed\xde\xbdo\xa3x\x818Q\xb0\x1f9\xe6\x07\x13\xbdOF\x1eJ\xd0kh\xf8\xda\xe5\xa1\x85\xf2d\xedq6\x97\x9e*)\x911\x8a\x8c\xd0b\x9d\x8d\xf2\x86\xad\xac\xac\x1c\x8f\x8fk\x7f\xef\xc8qS\x8fQp\xb0\x96[\xa5\xce8\x98x\x99\x03\xd3F\xbf\xe6o\x1f\x08X\xedUkv\x91Qx\x93\x11\x14t#nf\xd6\xe8\x11\xf9\xa1\xe3\xf9\x13\xee\xd1e\xa2<t\x13].\xfa\x04\xfe\x9e\x92\xbe\xcd?\xd4P\x83\xef\x87\xfbn\xee\x05o\x91\x0e\x9d\xbe\x1fO]I\x16\x99\xfd\xe42\xff\xea\xafmh\x80T\xb6\xa5\x9e\xc5'\x81\x93\x9eIx\x9eO]UUU\xb7\x18\xd8\xaa\xf4\xf1\x03\xb5\x11\xab\xca\x05\xfb-\xa6Tq\x82m\x81$+\xeb\xa2\x1e{\xa9\x8b\xc1\xdb\xd3\xf4\xb8\x98\xbfy\xaa\xdb\xb00\x02\x90\xb8l.\x1ep\x12O\xa9\xf7\xc4\x0fdB4\xd1\xbc\xafv\xa7+W\x9f\x0b\x1eK\x9f\xf8Mk\xe8\xe9=\xd6\xc9\xe8\x85\x00\x1c@\x0b\x9c@;\x04\xe0\x00Z\xe0\x04\xda!\x00\x07\xd4\xdb\x80\xcc\x87\xa6L\xb2\xf7I\xdf\xb3\xa6\xf6@\x15\xf9\x885a\x138RKt\x8aa\x1a,\n\x1e3\x93d\xd7\xf7\x14\x9f\xc9\xbcZl\x85\x0dFU\xa8idN\x0eX\xe7X\x0d\xa7\xf3qz\xff6&VU{2\x8e\x0c8p\x8b|\xbd7d\x03\"\xde\xc8v\x82\xaeJ\x0f\xd8\xe3\xfc)\xdb\xab\xb5\x16\xd9s\x9d\x8e\xe3\xb2\xe2\xcb\xab\x82r\xbd\xb3\xb9\x18\x85W\xaf\xf25tt\x1e\x1455-\x14\xee\xd9\"@\xf2\x17\xff\xf9l\xf9\xd0`\x8ak\xc3Q\xc9\xf0V\xefc\x88e\xf0@v\xf6}\xf3\xeb\x8b\x93\xbd\xcb\xbc\xc5\xf5w\xef\xde\x1b\x11K\xb0\xea\x84\x96\xb3\xd4\xfeVSJ\x17\xf7\xee1\x8ca)\xc0q\xa3\x12$i\xfdJ!\xa2h@\x94\xe4\xb4}0\xe3\x8d\xfa\\d\"\xe2\x96\xe0\x7f\xa7\xddjJ\x0fg,\x0f\xddB@\xc9E[up\xf8v8\x8c:\xae\xee\xb6J\xe4\xfc\xfa\xfe\xee\xd7h'\x11u\xdf\x83\x87\x92\xfcMK\xe5\xdf\x12&q #\xd3\x90\x99*\x89\xe6\xedD\xa3\x8cr\xe29m\xdf\xf3\xdc@W\xdc\xf5z\x19c\x9d`\xd2\xd3\x96\x14\xa8_H\x0d\x93\xe9\xb6\xac:j\xb4\x9a!\x8d\x8fS\xf4\xb2BD\x13\x15A\x08dd\x81\x1b\xd78\xc1F\x06F@{\x0cc\xaf\x9b\x1c\x90Z$h[E<nr\xdf\x9d5\xd7\xa3xOZc\xff\x16Nr>:^\xa4\xe5=S\xa7\xd2ai\x0f'\xa1*\x1anzz\xee\xbfcH\xe0\xaa\xbd\x96v\xeb{\xb6\xf0\x969\xe9\xa9\xcc\xd7\x9f\xf2\xb8#A\xcd\xccA$\xbaE%\xd8r\xf8\x8c\xe4\xb6]\x99n\x87\xa3\xb3\xe6\xbe\xb1\xe5\x0d\x8d\xbd\x86L\xd2\xa3+\x14\xdd\xf4s\x8d\xb5\x06ek\x0de\xee\xb5\x14\xfc\x18\xd3\xf4\xcb\xc7\x0d\xdf\xdd\x08\xd8q\xd9\xb37\xde\x91\xdb\x90\xc42\x1f5\x9e\xf9y\xdf\x08/\xaa\xb0\xec[|\x0d=\xbf\xbe]\xe7w\xd9\x9fO\xfar\x96p\x9b\x8a\xde?\xf3\xf7\xf9\x91\xfey\x0f\x95\xab\x94\xc0m\xd8\xe8\xba\xf9=Oi\x7f\xea\xc3x\xe5[Q\xf7\x11^\xbe\xea\xac2\xbb\x96\x944\x8f\xdd\xda\xbc\x02b\x06	\xc9\xf9y\x9f\xac-\xa7TD\xa5\xb7G\xcb\xb5\xbe\xa4\x90\xf4\xf1\xd1\xa4\xce\xad\x13\xfa\xd3\xf7<\xa5\x96{c\x04YEa_\x1d\xba0\x13;\xb2\x9c\xd0\xda\x0d\xd6\xfc\x17\xc6\x92Z\x98DB*\x85\xaa\xe1\x9a\x8d\x1ad\xea\xc3>\xee\xfa3~\xb7\xb3\xbf\x0c%\xbd\xec\x1e\x03j\x18\xe2^\xfb\x84\x9c\x11\xb8\xaa\xeb?\xeb	GG\xbc=\"\xd4\xa8\x93\x88\xfdE*>\xc7Q,,VUS\x93\xcf\xf2\x98\x9e\x16\x91\xe9\xeb\xbc%8\xe7w;+\xd0D\xef\xbd\xf3\x0c\xf8\x93`\xbey\xdf\xbe\x8c\xd5A\xb4\xd2r#DKM\xfd\xd9|0y\xb9\x9dQo\xc9\xe9\xe4\x13\x11M|\xec8\xa1\xb5\xd8\xcf\x0f\x9e\x08\x1fX\x97\xec3e\xb1\xf1\xd8\xc9qO\x9f1\x82e*L\x18{\xa6\xd6IP{\x96\xe5Td\xa9g\xe6bwl\xa2\xa7\xa7\x1f\xb2\xcb[\x11\x13\xf9\xe2>\xb7;\xc3\xc1\xe5u%\x06=J\x05|\x16\xb8\x02\xba\x7f\xaeozKJJ\\\xee\xca?\xee|\xec\xcfH\x82\xcbI\xb9xI\xaf\xc3|~}C~\xe9\xbb^\x1cy\x86\x97\xf8=\xf7i\x0c\x8bJ\xb2\xa0\xf5\xf7\xe0IAm]\xfe\x907\xfbiG\xeb\xe6V8\x87O\xef\x06M\x10 \xbdN\xd1\x97\x19\xbeu:Q\xa1\\fp\xbd*\xd6\xcb\xc8\x10Ms\xf9\xd4\x14\x16\xdc\xafv\xc6o\xdfN\x0c\xcc\xce\x86\xfe\xe6)\xef\xcb\x95v\xff\xc0ftq\xc0jY\xe7\xf6c\x1c\xa12Vp\xdf\xb3\xf2\x97\x98\x96N\xb4\xee\xddj\xa8\xce\xdd`\x87v\xde/\x18a\x87?k\xc6\xd5w\xcdi\xc4\xd9bX\xae\xfd\xe6~x\x88\xeb\xb4,\x8f6~ll\xfc\xb0\xef\xb0\x18A\xd9vd\xbf\x15\xbd0v-\x84\x13\x19hB^\xdc\xf0d\xf6\x93\x9c\x90\x16\xe9\x04)\xa9\x86\xbe\xaf\x82	\xe9\x9dK\xf7\x07A\x06&\x8a\x18\x13\xaf._\x0d\x15\xb3\x97\xb4\xacd\xbf+..\x9d\xff\xc8\xd8n*cS\xe8\xbe\xaa\xe0\xf8l(\x97kn\xafu[\xb2\xd8\xff|c\xe2.\x07.\xca\xde\xf3PTX\xc3\xfdF\xe5\xf2\xd7JOV\x16\xa44\xab\xa3\x87\xf1\x016\x8e\x87r\x9c\xa2\xa2\x89\xe9*\xc9\xde\x1db<\x19`\x83\xce\xcan\xb6\xf9\xb5VK\xb9\xf6\\\xc7r\x1a\xc1j\xbf24\xd3-\xab:\xf4\xcb\xfe\xd6\xe7Lk\x82\x86\xbe\xa8\xa4\xd0 ZH\xf90\xb3{)\n>ki\x97\xf5\x80\xfd3\x9c\xf0\x1d_\xc34\x06\xc5\x8fJ\x84\xc5\x00{\x86\xf0*M-9qku\x97th\x07\xe3\xd3+\xeet\x87\xde\xe5\xb5]\xcfC\x16[\xa3\xce=\xee3\x84\x9d\xae\x96\x96\x96\xec\xcb\xf9\x05\x1cu\x03C\xc3\xeb\x942\x83z\xfa\xcf\xc4a\xc8\xf7\xf2\xc3mY5\x1fX*'(\xb2\xa3\xa0QP$9\xc8\x05p,\xc0\x06\x14!R\xf8@\x13DA\x91\xe4\xa0\x95\x10\x8a\xfcQ\xcd\xd0\xbd\xf7\xc3\x9d\xe5\x13\xf6\xb1\xe1\xcb\x97M>\xba\xba\xce\xa35\xcc\x19\xc3\xeb\xaa\xaa\xa7\xaf\xf1\xf0`*c\x89\xd9\xd6\x14X\x02\x9d\xa6r\x81\xd2\x02\xd4\x84\xc1\xd9Y#IN..\xb6\x82\xe4\x84\xc9\x8d\x0e\x850\xd4\xafg\xbf=\xef\x8c}g\xc9W\x1dRR\xe6a\x95\xb2q\xe0\x9e\x7f\x8a\xd7\x05'\xba\xdf\x94\xdf\x91\xd4\xa1\x12np\x0c\xa3\xe8\xa8\xe6)\xae\xc6\xeb\x93\xaf\x89\xc4o\xfb \x13P\xed$\xe7!\x9a\x9a,\xb1\x1f1Q\xfeKM\xb2%\xefw\xb2mTt\xcc\xfb4\x9c\x85\x9b\x92\xaf\xee\xe9D\xb0oPT\xe8\xb3\xf2\xe67\xba\n\x1a\x87\xcf\x9b%\x8c\x1b9\x1e\xcfl+*:\xb1\x07\x05\xca(-\xde\x0b\x82(\xff\x08\xd9\x88vB\xcc~\xff~\xc7\xc1\xdez\xd4 \x90\x11=\xa2\xa2\xe3R\xc8]QO\x84\x9a\x9f\xcf=~\xb7\x89\x0eG\xdb\xc7M\x19\x05\x86\xedp\xee\xcc\xfb#\xf5\xa1\x12u\x8bd\x0faEB\xb0\xee\xfe\x84\xe1>N\xce\xe4_\x83_\x15=\xde\xb6\x18\xa1\xe6v\xb5\xe9\"\xea\xb6\xd7\x90\xf4x\xb2\xc4\xefsF\x9dX\xc9\xad\xad\xf1\xeePD\x9b\xd8~\x15$4\xec\n\x14v^\xe9\xaa\x19\x9f\xff\x86\xa6!.\xd9w\x15\x12~\xe4[\xff;\xd9Q\xff\xf0\xf3j\xfb\xcd\xaal\x10Z\xbe&\xae\xae\x1b\xad}\xe9a\xba\xbc\xe4Zg\xf9\x13\x0b\xa6\x80\xa0\x0d\xc7\x0c\\V\xb4\xb5\xb5\xcd\xcd\xfb\xf2\x88\x9a\x10[\x05\x1dR\x0cC\xa1\xd7\x0e=\xc9\xc6\x14\x00\xaa\xces\xa3\xdd\xf4\xf6f\xd5\xf9\x8a{\xc5\x9d\xfa\x1cg)A\x06\xfe\xf6\x19n\xc8\xf3\x0f\x1dH74\x17\xd4i\xc8\xf1\xae\x9a$\xb9\x92\x8e\xee\xc7\x80\xc0\xcb\xae7\x1f\x98m\xe2\xbb\x06F\xa2\xa0Hr\x90\x0b\xe0X\x80\x0d(B\xa4\xf0\x81&\x88\x82\"\xc9AO-\xc8\xfcHHe\xc9y\x04)5\xfa|z\xc3\x81_\\\\%\xf0Q)*1\x1e\x1b\x85\x1e\x12:\xb5\xf9\xf8\"Fl\xd4\xe1&2\x8f\xc5\x8c=\xf3\x1bH\xeb\xfb\xe2\x16S\xa2\xf1C\xdd:*S^?\xf1\xfb\xe2\xfa\x99Y\xd8d\x8e\xac`\xb6-wA\xcf\xfa\xa2\xf3\x93\xe3\xda\x8c\xdb\xf9{\xd6\xd6\x8f\xff\xcc\xf6\x84?\xff\xf8\xf4F?\xc1\xce\xd81\xff\x9d\x8b~o\x84S\"\x8bS\xe1`[.\xfb\xc3\xea\\Q\xd8)\x13%\xf8x\\/\x80\x8f\xb8\xb7T\xcbG\xf2gf\xfa\xcc\xad7\x80\x7f\xae\x8fwid\xf6b\xd36R\\_)f\x18\xe7\xbd\xec\xbag\x83\x8b4z\x96\x03\xff\xd7`?\xb5\x9c\xd8\xf4\xf6\x1f\xef\xdegb;\xb3\xe7\xac\xdd\xe8o\xd5\xed\xa6'\xef\x82kk\x9dW\x96\xee\x11 \xe4V\xd1\x8c\x0e%\xe5\xe5\xe5&a\xe2\x18\xf04\x97Vn\x12\xdc\xf1\xd0\x8f\xc8\xf5'\xea\x98\x99\xe9\xb7\xcf\xaf\xfdn\x19\xba\xfd\xf2H\xb0\x17\nzsss\x93*/5A\x14\x14I\x0er\x01\x1c\x0b\xb0\x01E\x88\x14>\xd0\x04Q\xd0vO\n`\xf7I\xd6\xa8\xed\xf55\xfa,\xcd\xff\x9aH\xff\x99.\xf6\x84\x8dV\x11?\xa9\xe0}\xa7\x01A\xd3\x13\xba\x1c\xfb\xebS\xd5\x9f\xf1\xcf\"\xe4\xad\xf9q0\n\x1e\x1c\xeeN=\xc6Y\xcd\x9f\x99\xacW\xfe\xf9\"\x96\x97\xa3\xe5\x95 \x8e\xe3\xb1\xder\xc8\x10\xcb+\xab\xca\"\x97\xf5\x15\xca\xd0V\x85\xb1%\x8f\x13\xe1+\xce\x9e\x0d3\xc2F\x1c\xfd\x0b\xc7\xd1\x0b\xd4\xd4q(\x06b\x95\x05\xf5k\xdc\xe9j\x83\xaf\xf9\xe2r\xb0\xa1\xe1\x14\x7f\xaf\x7f\xe8\xa7\xc6\x0fL\xad8UDbP\xd8\xb7\xa4\xa7/\x9c$v\x10\xcaI\xa2\x94j\\\x17\xd4'z\xb7\xc3\x99\xce\x9f\xd3W$H\x89\xd9\x97Id\xb5\xbd\xc3Q\xeeVa\x02\xefo\xe6\xdb\xa9>\xe0l\x19xr\xa9\xd2Nr\xea\xdf:]\x1f,\xb5\xdfu\xa0b\xfa\x80\x9e5r:\x97\xe3\xacD\xe4\xe6\xb3\xf6\xa9\x15N\xe4l\xdb\x81\xb6EcJ&\xe8\x15~;\xadt\xa7\xa4Y\x98\n\xdd\xb3;\x0e\xc9\xaf\x82\xfb\xd7\xee\xf3\x11\x84R\xf2p\x9b\x85\xf9f\xb8\xdc\xa4\x9bR\xf9\x93\xc7\x01\x81\xc7\xd7|T\x97\x8e\x97\xe2\x9a \n\x8a$\x07\xb9\x00\x8e\x05\xd8\x80\"D\n\x1fh\x82(h\xbb'9\xb0\xfb$;s\xc9Ur\xda\\\xc9\\aFA\xea\x1f\xda\xb6\x95_\x84t)\x80\xf3\xd3%)\xca\xff\xe4\x9a\xbb]\xebz\x1d\x82\xabIQ&\xdeo\xddt\xdd\x1a\x91\xbe\xb6\xcc\xd7\x97\xac\x00\xc9s\xea\xb0d5\xfe\xf2\xfb\xa2^\xc8\xfe\xe2\x1b\xfc\x1b=\x19b\xdc\xb5\xb3\x83W{t\x8e\x88\x91$\x84\xc5\x11[Gk\x84v\xd2\xf9b\xf3\xe1\x81\x9c\xaa\x80\xdf\xe9\xb9W\xcf\xde[\x122\xf1\x0f![\n\x97\x7f\xd2\x9c\xd4W\x06\xb7`0\xd4}\x02\xd4\xb6\x83\x8f\xedX\xc5\x8do\x14b\xe4Z\xa8|\xf6\xa8g\xe5\x05\xed\x007\xe2\x98\x9cx\xb7J=e\xc3|\xdf\xadj4\x04\x95\xc4\xf1\xdc\xa8\xcdc,\x9f\x81\xf8\x0e\x8e\xfc\x92\xbax\x84S\x83\xc3~\xbeJ\xe4\x19\x12\xdd\xf6\x91\xff\x9a\xd1m\xe8q\xd9\xe1\xed\x1b\xb5@\x88=)\x92o\xb2\x95\x90u\xfd\n\xd63\xbd\xbfs\x9d\xf5\xb9\xcc\xa0\xde\xcfF\xf2\xb9\xce\xc9\xa5\xa0\x87\xb4F\xec>\x164`\xb8k\xb9?H\xdd\x84'\xc5S5\xe5\xbb\xb5Z\xb2\xa0\xf5\xf7\xdc\xa71,*\xc95B\xe6\xef~F	/\x1c\x1dF?\xc0\xba[-\x13\xec\x90\x1c]\xb8\xbc4?Ne\x8e\x7f\"P\xafv\x8f2\xa8@\xe0\x1b)\xc3\x83\xe0\x98Zb\xd6\xd0\x99\x9dU\xb4\xbd6\x7f\xd9\x13\xd7\x89,~\x17\xdd\x1f\x1f\xdb\xc8\xc6\xc6GU'\xbd\x84\xeep\xef\xdc\x85\xd5\xcd\x1f\x1dF'; X\xf1\xab\x9dCZ\x1e\xdewW}\xbf\x1cxFl\x1a\x90\x83'`S\xd1Sk\xec\xbcRF'\x9a\xc9\x1f\xbdbY:\xe6\xad@\x8b*\xd9\x1a\x8aLZ\xd8\xa3\x95\x13\xe3\xdc#FE\\nz\xa9\xf1Ut\xcf\xb7\xb5\xd4\xb6	\xbdCh\x8f\x97%\n,yn\x84\xc9\x9e$@p\xda\x16o	\xcd\xed$\x90\x19n3u\xa1Z+\xc9\xf7Hp1o*\x1f\xfev\xa4\xf9\x00\xc2\xc3\xc3\xa7f.\x0d\xea\x1d|?\xb8\xa8*=\xbb\xd1\xda\xe9\xa1\xba\x8cAX\xa2<\xc3\xa6\x1c \x07v\xe6\xe6\xab\xd5f\x12\x1d\xb4C\x8fk\xe5vr\x1f@wc,\xb0\x90\xa5 \xea.\xa8\x82J\xe9\x82\xaf\xf8@\x0f\x02\xe7\x00\x16X\xc8R@\xe5x\x17$o\xd8\xb7zL\xec\x89O\xcc\xbc\xfd\x92\x15\xef4\xae\x927&g'\xf2;\x8eV\xfbW9;\xbe\xcap\x9d\x90\xed;!s&\xb7\xb4@\xfe\xfb\xba\xb4\xbc\x05C\xd7\xec?0\xb9\x0eO\xf0\xd99\xbeP-M\xc6\x8cu\xb9v.\xa7\xeb4\x9d\x8d\xbb\xf6f92\x91'\xa7$G	\xb3\x11 \xdc\xa8\xd7\\\xd85\xaeV`\xa9\xcd;\xfc\xb9\xcc\x0d\x994\xf9T\xcbB\xcf\x10>n\xdc?\xe4\xe8HS\xfd\x9c7BP\xdd\x81\xdd\xa6\xa5\xfaO\xc4^ ^>\xca\xf4|\x8d\xcej]_\xecnh\xcf*\x7fHKMCc\xddA\\\xb9\xf1\xc4\xea\xec\xf2Z\x98\xdb\xa8\xd9\xb3\xac\xb8x\\\xdf%\xd0\xd7\x07\xc1I\x82K\x8by\x10\xd2\xc2`\xeb\xd7v\xfb=O)\xc1/n6\x0e\xb1>qz\x18\xa0\xbf\xf3\x1b	M\xa8]\xd9d9\xf4\x1cjzS\xbe\x88t\xdd\xfc\x9e\xa7\x94\xc0m\xd8x\xcc\xe8\xa1\x13\x95\xda\xfc~\x9b\xbeY\xd8\xd1\x1d\xeb]\xf0&\xf3\xa7\xb1\xe8h\xf2\xc8\x9a\xf6f\xd9\xf7\xe5\xfc\xb6$&\x9f\xb4\xaa\xb1!\xdf\xc7/\xa3y/\xe8\xc7\x92\x89\xd4\xd6\xc6i\xf3I\xab\x17\x07\xcb$\x8c\xa8h\x0fr\x0b\x96;Eu\xc7\x1a\xd7\x8a[\\\x0d\x18\x10?\xb9_\x9e-\xf9\x8a\xaf\xf2\xf2\xe6\x94\xe3\x16\xe3*\x1ex\xf2I\xa6\xad.5\xed\xeb\xc5\x00\x95\xb1\x1d\xbf\xdf!+\xbc\xa4\xfem\x17C\xec\xa1\x94\xc7\x91\xb2\x0b\x9d8\x93\x13\x19D0saz\x16\x18\x0b}>\xa7\x8f\x89!O[\xa8s\x00Fw\xe0$\x0eL\xfc\xa2\xce~N\xe2>c;o\x985z\x94\x9bW\xdd\xd1\xcen~bz\xba!\x88O\xf7\xdbc\xffo\x12\x12/\x0c\xaa,\xf0>\xdcSB\xac\x0f\xff\xb9\xbe)\x08\x96\x1d\x88\x82\"\xc9A.\x80c\x016\xa0\x08\x91\xc2\x07\x9a \n\x8a$\x07=\x1f\x81\x94_\x7f\xaa\xff\x8b\xcd\xec\x85W\xd4_'\x15NEp1I\xf5\xe5\xb5\x9c\xecnE\xa7\xeb\x0fI\xaf6\xbf\xdcv\x1a/\\\x83\x11rf\x11A3\xc6\xcf\x97)\xa8\xd9\x0d\x97\xbb=\xa5\xfb\xce1\x1b\x99l\x93\n\xc3di\xc9:\xd1R\xeb]F\x15\x86o\x06UT]~F\x8bz\xbb3\"\xcd.'\xfc\x9c\x9c\xce\x82e\xfd\x7f\x1d{97>~\x1d\xed\xf0CPC\x17q\xb7\xa3\xc5Q\xbf\x0f\xfaCv8\xd7\xee\xa7S\xa2\xb6\x864\x82\x9c\x08\x17\x157\xf5x\xb5\xdb\x14a\x1f\xb2bz\x81\\5\xa5]1\xa1\xce7[V\xd7\x18]\xdc_yI\n=\xc5\x84\x06p\x90\xcb'A\x96\x84O\x0f\xa5\xa9\xca\xb0?\xa7\xf0\xb9+%\xacVaI\xd5\x81\xaf\x14`\x06\n7\x00\x16\x04H;H\x14\x17\xa8\xc2\x92\xaa\x03a)\x14`\x05\x90z5/\x9d\xce%\xa8$\xf2\xdf\x13y\x80;\xd7\x7f#\x9f\xafu\\\xa1\x95~{\xfe\x8b\xf2\xe0\x80\xf0a\xf6\x16_\xf3E'\x87\xe7(\xdf]\xb2\xf8	3\x05\xe7\x80\xee\xc4w\xa4-9\x0c\x17\xa5\xe1\x06\xf6\xd8x0\xa2A\x0d\x0c\xc7\xe6/\x94\x83\xfbtr6J\x02\xa3T\xa9\xaa\xcar2\x1d\xae\xb1\xb1	L\x13kR\xbc\xec\xd8g\xbd~\xf5\xd6\xec\xca:\xe1\x03\x9f4\x1b\x9b\xab\x8c&\xcf\xea\x9cM\\\x1co\x8d\xf6\x11\xc7\x96r\xc5 \xf7\x8f\x0f\xe2\x0d\xbb\"\xe7\xddF\x07\xb8\x00\x07\xd9\xfa\xeeLh\x0c\x17c\xee\xc3/\xa4\x95\xe5\xce C\xb8\x0bq\xe0N\xfb\xac\xd0.P\xf1\xbb\xe8\xa9-_>\x8c\x95^aP\x81\x93^\xc4\xa9\xc1\xdeg\xa5\x1d\xc1\xf5\x0c\xc2T},\xa6\xd3\x85\x9e;\xb5=\xa4\xd2\xc1\x04T\x95\x93\xc8\x05\x9f\xfex\xaeK\xc8\xcbj\xbb\x99*/4\xef\xde\xdd\xfe\xe8\xd3\xed\x00)\x93\xa4\xeb\x97\xf8@\x13DA\x91\xe4 \x17\xc0\xb1\x00\x1bP\x84H\xe1\x03M@U\x01\x05\xd7\xeb\x19\x9ay\xddZCm\x99!\xee\xae1\xd5\x0ez\xaa[iBD\x0d\x99K\x035\xf7\x83\xa65\xc8\xb5\x07:c\xaf\xac_\xb8^aRg\xf8\x92\xdc>\xde\x94\x895\xfeB;\xe6CV\xa8M\x1d,\xce\x00w\xb0M\x11\x96\xd2[\xa8_\x14\x0f\x89\xa7\xf4\x0bu\xa4\xa0\xcc\xb1\x1b\xb2\xcbUN4\xd1\x18\xe5\x91\xb8\xa5\xc59\xdd\xefu\x99\xab_F\xf7\xf3<\xe9\x1e\xe0\x0f\x12!\xb5S\xf5\x90.Hf\x9b\x99q\xf5~\xb0\xf2\xde\xc8\xf9k\xd3\xd2\xbb\x15#\xe7\x0d4\xdb*\xc3#\x88q01\x0e!\x8f\xcc\xe0\xa0\xfaK<'\x96\x07Y\xf3\x13\x1f\xcbzo>\x96n\x8e\x95:o\x97n\xa7\xa0\xe8pDO\x08\x04\x1e\x88\xa2\x1c*\xc6%\xa6\xbb\xc9\xf9\x8e\xcbs\x87=\xa9\xee2\x8cz~vfM{\xd31/\xd3\xc9h\x93\x88\x7f\xf9{\x99\xa4\x9e\x04^\xa7q4\x98\"\xcc@\xcdE*\x83\x0f4A\x14\x14I\x0er\x01\x1c\x0b\xb0\x01E\x88\x14>\xd0\x04T%P\xa4}w\xeb\xfd\xd0\xdb\x14\xdd\xfc\xe9\xfb{t\x16\x1d+#\xca\x8c\xfc\xe1\xdf\xdf\x13T\x1e\x1a;\xa0\xd13z\x8a\x8aO\xbb\x83\x068}\xb6{\xee6x.J\x88]\x8f\xa4\x1d\xf0\xb4@\x12\xb8\x1b0\x1d\xee\xe2>	o@\xe5%\x05[\xc7\xf1\xdd\xfe\x19\xae0\x9fd\xa8[\x9d\x9csDYub^Z\xf6\xc7\x8c\x8dE\xa7dD*q\xb7\x90\xb7y\xae\x14x\xae\xfd|\xaa=\x98\x81\x9a,d\xec\xc2i\xc4QMz\xbb\xa7\xaa\"\xdd\xb33c\xfcg.]\xfct\xce\xbb\xcal\xf1X\xa6\xcc\x8a\xf6\xc2\xfd\xfe\xf3`Sx\xf7n{kQ\x1e\xc2\xfd\x985?.V_\x89\x91\xe2U\xde\xd2\x14\x8a\x18\x9d*A\xd4W\xb8$\xe5\xff\xb8k%j\x91\x01v\xaf\xa5\x07\xbdq\x1e\xd5+\xdb\x87\\\xc9b\xcd)\x8c\xa2p\xe6m\xff\xc2z\xcb\x80\x805G\xbaG\xc2\xf8%.\x10\x16C\xe9i\xfe\xd9\x8e\xb4\xb9i9\xf9\xa9\x83)yJ	\xdc\x86\x8d\xae\x9b\xdf\xf3\"y2\xa6FL\x04b\xd9\xd2\x8f]\xb3=\x15<\xcc\xeeO6Z\x0d\xdc?\x9e!\xd3\xca\xaahe \xbe:~\x9cjm\xf3\x0e\xbd&g\xabD\x82%\xd07r\xb0\x9b\xf7\xe2d\xf2\x08W\xac\x86>\xc3\xe7\xe2^*L\xe7\x18N<T\xf6\xfbUA\xb0\x07\xcc7\xa4*\xa1~y\xef\xc2+\xf4x\xab\xd1\x81\xa8\x89\xc9L\x9e&\xedI\xef<=\xb1C\xd3\xb6\xc3\xc0m\xff\xed\xca\xa0\xefe\xf4\x86z\xd9\x91\x197\x03\xac\x9e&\xe6\xd5\xd0@e[\x0e%\xedo\xb1\xd6\x05'\xaaDR\xdb\xd3\xd8\xe7&\xfbM(\xe9\xeaI\xa2\x18\xa6\x87\xa2\x18l\x0c-\x91\xf0k\xd4\xcb{\xe7\xb4\xc8,t\x80['cS\xa9?\xed'\xafp\xd4yj\xaf\x9d\xf9\x03\xfa7}},3\x8b\xa3\x94\xb0\xe3\x02*N\x18\xf9Yr\xe7\xd2wr[\x97\xd0'^{#v\xfa=\xbd\x98\xb0\x19K\xcb\xc7\xbe>e\xe4 \x17\xc0\xb1\x00\x1bP\x84H\xe1\x03M\x10\x05E\x92\x83\\\x00\xc7B:\xc3\xc1kQ\x0f\x86\xea\xa9\x1bUgf\xe6Y\xbd~\xa3$\x98%q )\xdd\xfa\xdd\xb5\x9c\xbc\x1b\xe7\xf9\xd3\xed\xa9\x83\xd3\xed\n&\xbe\x0e\"4\xaf)\xff\xdd\x89\xc5s\x91\xf4\xa2c\xe4\xd4\xe2G\xe2\xc5\xb7<0R\xa4\xac\x16yM\xb2\xd4\x98R\x1b\xda\xc3\xb1uF\xa4\xf8I\xce\x88 \x91`\xf8\x82\x91hZ9*b\xd4\xd6u\xa8\xde\xa1\xf5\xdeE\xfc\xe0\xb9X6zyD\x0e;\xfe\xb5'\xcd\xa1\xea\xb3\xb3\xbc\x99\xc5\xef\x040k\x8c\x8e,\xe1\xea\x82\x125\xae\xaf\xbdC&\xfc\xc9ji\xd9\x96dK*J\xbd?\xb5z\x0f\xe4\x95\n\xedyS\xae\x83\xa3{\x03Ha\xdfZ\xc3j\xb2BP\x01\x0d'\xc2K,\xb4fZ_\xd8\xca\xad\xb4W\x03\xd8\xd8\x03{\xa7\x1c\xc5r{\xdec\xacO\xda\xdc+K]\xde(\x128?\xe8zJ+\xb2\x10\x84\xf6\xbf$|\xa2\x9a\xd6\xb1\x19\x1fE\x120\xfd\xa2\xc34\xfb\x9aK\x13DA\x91\xe4 \x17\xc0\xb1\x00\x1bP\x84H\xe1\x03M\x10\x05E\x92\x83\x9e\x0c 5 \xdb\x1b\xff\xd0\xa0\xdb/\x11\x17\xd3Q\xed\xe9\xa9\xea\xc8F\xf3dHDgQ\x8c\xc6\xc1\x88\xc2\xfc\xec'\xd4\xc3\x0c?\x98\xca\x93[\xe2=L\xe1\xe3\xc2\x88\xe6w\x9b/\\\xc6\xa64\xa26dx_\xa5^H\xa5^\xa4\xd0\xb1\xa3qP\xb0,!4\n/)I\xd7\xba\xe5\x97\xd3t\x81+Ge\xfe\xd4Fj\xd7O\x8bRV\xee\xaa\x8be\xeb\x99\x96D\xe5\xa6\xb867\xef\x16z0$\xe1\x14BH\x8e\xa2\x0f\xff\x056\xb0YX\x10\xce\x9f\xab\xf0\xf7\x9bOX\x04\x99\x82\xe4\x12\xde\xdd\x8bVs\xaa\xdad&\x17*F\xbc\xf8`\x9c05\xc6\x00\xbb\xd0[\x86\xa7\x96\x87\xe6\x9f\xc8j\x1d&\xe5g?\xd5!\x1d\xd7.,\xfe\xee7\x98\x13\x1a8\xa5d\nq\x15\xf1\xd1E\x8c<\x1a\xb1\xb9\xf7\x10F\xdf\x19\x1d\xbe\xbd\xecmF\xf7\xcc\xff\x0fF\xac\xf8\x11\x06\xb9\xf8\x91\xe3\xd7?\x87\xd4A\xc2\xe2\x1f\x9c\x10f\x1f4\xcd\xa18\x91'MN3\xbd\xb9i\x1c\xa7\xb7;\x86\x04NZ\xebq7{-\x9f\xec\x19\xbf\xb9\x03\x1aB*3\xbc9\xa9\x9d\xa5R\xbd\xd4x\x14\xdeOi\xe1\x11\xf7\xd9\x11\x078\"E\x8a\x85\x86\x9e\xcc\xc8z\x0c1\xd7\x1e\xfb\xa3\xce\xeeYb\xc7\x0c\x96,\\%m\x17\x1bJ\xa3\xf8,\xf1\x87\x18nnG.\x95\xe4\xcf\x90\xe7\xe1\xe1\xbb=\xd3\xd3\xe7\xef-\x94\x1a#\xd3n/\x9e}\xedAZ\x04\xde\xe5X\xf7\x99T\x8c\xdf\xbb\xde\xafp\x1cJ\x1b\xfdss\x94!\xfb\x08\x14b\x83	\x90I\x0d\xb41\x906@\x9d\x08\x14C\xa4\x1e\x81Bl)\x93G\x80\xbc\x0c*R\xe2\x93\x06L\xea\xe66\x8f/[<\x8fX'8\xa0]T9\x079\x05.\xea\x9e\x0e\xd80\x06\x1fJ\x82\xd6\xe9\xc8\x99\xb1\x02\xb8\x84)\xf3\x00z\x95\xef\xcd\x00\xeb\xf4\x03\xf8\xa7\xbb\xa4\xcdk\x85F\x9f\x8b \x83qq\x8a)N\xab{\x16\x1f'\x93\x90-\xad^'\xec\xca6\xca\x04\xb9\xcc\x0f\xd1nYwV|c|3\xaae\xf5\x96\xcaB\xc3\xc2\xbeC\x8d\xeb)\xce\xabB\x7f`\x98H 5:~;Uk\x9f\xd5\x94\x16!\xce{(\xa9X\xba:x9)|\xde\x88\x8bl\xf3H\xdf\x13NVi5\x90?\x85\xacJ\xef\xb1\x7f\x83\xcb\xc9W\x9a\xa8-\x06\xa2\xbc\xaf=\x13\xc7\x1c\xe9\xa7\xd9\x1b\xda\xd13\x9b\x9dqj\x92\x9dh\xad\xef\xa6!\x1fz\x92\xf8EwzL\x1b\xbc5\xd9\xc5 \x13!\x0d.7\x99\x91aa\xcd\xa7t\x15\xab\x01\xf6\xc4\x9e\xf0\x17\xf4\xcd\x96\xd0\xfeE/\xb2\xb9[\xee;\x1f\xb4\xe8\xdf\xcd\x8fB`\x9c\xa2r\x01Cnw\xef\xe6;{\xf6\x93\xe4\xbd\xea\xc1\x1c\xda\xa1\xfb\xc9\xc8\xce.s\x84\xf3\xfcl\x94\x15\x03\xc9\x0fX1\x90\xfc\x80\x15\x03\xc9\x0fX1\x90\xfc\x80\x15\x03\xc9\x0fX1\xe0\xb4\xfc\x80~\xe6\xf7\xf7\xefU\xc9\xe6m\x8b\xd3\xd6\x92\x9f\xaf\x0e&\x1f\x91\xd9\x1a\xccZ0\x86,\x86*c\x9ap\xedN31=\xc0\xd3\xdb6j_f(\xef\xa9.(7;K\x97E<\x7fIh\xe5-\x8a\x99\xfe\x9e\xfb\x19	\xa3\xc5sQ\xf1\xd6\x16\xba\xd1\xca\xca\x05ao\xa27\xd3\x12\xeb\xcd\xcdb\x89\x83\xafvfk\x95\xae9ED\xa8\xb1\x0b\xc8\xb2\x8dy\xf3\xbbU\x970\xb3\xac\xee\xd6\xa3\xea\x9bkj\x9a\x7fM\xef\xfb\xefV1\xbcx\xeb\xb9\x9d\xec\xfdn-\xab\xa0\xf2\xd86!\x17\xce-\x84\xddh)\xd9\x9b\xb2\xba\xf7\xe4f\x04\x0fq\x84\xb6\xe2e\xb4\xbf3\xcf\xdf\x8f^\x97A\x07b\xa7\xaf6\xc7-5\x0by\xf4+))=\xf2\x7f\xe1\xee\xb9\x9d<~\xf3;\x93\xb5\xaaq\xf6\xa5\x83d\xe8N\xb6\xc7A\x0b\x87|\xa2\x8e\x0ba\xce\xad\x87\xa8\xbe\x05V\xa7p'\x83k\x03\x1e\xf10\xddB\xe3+\x99\xcfb\xbc\xc6V9\xfa\x94kK\xbb\x9d\xcf^\xd6d\x047Uk\xb5\xe0`\xc6w\x9b	2\xce\xdaA90F\xf20\x9c\xa7\x88\xf9\x97\xc4^z\x18\x93tb\xfaH\x0e?dbbbbbbb\" .)m\xf2\xc9\x9b\\\xdeP\x97u1\x0f\x02j\x06\x1c\x8b\xbeqF\xa10\x07(\xc5NU\x9c\xdd\xa4U*\xa5\xa5E\xbeJ{\xcd\xa3\x12\xe7\xab\x0f/K\xed>\xa2\x9f\xc7=\xe2\xad\xfa\xe8UO_\x9cR\xf9\xf8\xeb;\xf0\xf4\x8ewZ`\xe9\x04\xf9\xe7\x19\xbb\x17_\xde<\xe0\x17\x10\xa8\xb4\xf8vg|rB\x9a\x81\x14\x82\xe2\xf4\x08\x9d~\x81\x81!\x845\xbaf\x96\x92-\xbep\x06\xd16\xb2\xb1+\x7f%\xf6\xf5z)\xeb\xe1\xf8\x1f\x0e\xfc0\x05\x8clF4\xeb3^\xb9\x8d,\x01\xaaqK\xcf\xdbQ\x9cd\x1c\xfbE\x84\xc1\x1fJ\xc7z\xc9\x97\x18s\x15\xdc\xedY\xe0j=cET _6B\xb0\xe3\x07\xdb\n\xa1\xe4\x9d\xc3\x9c'%\x1d\x9c\x0d#\x07\xed\xad\xb1\x19\x92\xf3\x898\xbe\x07b\xbc\xf6\xdd\\}\x9f\x95\x95\xa5w?\x86{\xe6O\x06\x19\xfb\xbeF\x0f\x9dI\xa0\xd3\x03\x19V\x97\x02n\xd1\x97\xde\xa7\xb2v\x0e:\xf5\xf8\xbcY\xcc\xeaMZb\x9e/\xb9+q\x88-L\xcd\x1a\xb7\x17\xef\x93\xe0bB\x03\xa4\xe0>\x04\xce{\xf5\xa2\xa7\x05\xd7\xee\xbd7\xbd|\x9b\xad\x16\xd3\xa1Z.\xb0\x01O\xed2\xe2!g\xfdf\xb2\x17N\xd8e\x07T\xa2\xdf\xbe\x05\x81\x08Y\xaf\xefT\xca\xfa\x17\xe4/\xe9\xef}\xca\xc5\xfc\x81\x1fe\xa8\x17B\x13q\xd1\xa8\xf4Y9\xd5\xd5pd\xcd\xf0Q\xf8\x87\x0f\xa6j\xac\xec\xecfj&\x06s\xdb\xec\xecK\xd4\"\xca\x833\xee\x7f\xf2f\xabIE\x0c\x0cf\xa7c<h\xc1\xba\x7fL\x1b\xcf\xb5\xf0\x9fK\xfa\xde\xa0\xb0C\x84\xd6\xa7T\x17\x93\x1e\xe2\x8b.Y\xe2\xcf\x8f\xab\xe2\xed\xc3\x18N\xb2\xb0\xac\x02\xb0[<>\xe2\x08\x85FL\xc6\x9f\xd2\x8b\xa5\x9e\xfcAw\x85\x91\xa7r?}[Uq\x93i\xcf7\xb5\x89xYX\x84\xc6\xe0\xb2\"\xc98 \x8e\x92#\x1d\x9a|\xa5\x0d\xfb\xf0D\xa6\x06\xaf\x8a\xd6S\xf7\xeb\xee\xaf\x80]\xc5\xc3#T\x84\x91\xe7\x8b\xc2\xc3\xa8\xf9\x84\xcbw\x83(\xcb\x9e0\x06Q\xfc\xf8<\xb5\xac\xd1\xd1\x0f\xdf\xeb\xdc\x1bR\xe9\x0e\x14\xefg#\x96\xf6\xbe*\xea\x10\x98q\x06%\xe3<\xb7\xb5\xb5%\xf6\x87\x07\xe1\x9d\xb7\xa6\xca\xc1Ip1\xa1\x01R\xb8\xa2\x9f	\xedNs\xfciv\xbam\xf5\x96\xcf\xeb\x92\x93KvT\x9cRiwL\x8c\\-1\xa5\xca\x88\x97H\x1f\xd4N\xa7\xf8wT\"\x95\xc9\xc8z\x98\x0b\x96\x86\x85R\x84\xca\x978\xec~\xb5\xc0.\x17\xcd*3\x0f\xe4\x18\x85\x1c\xe6CV>\xdd\xe9J\x1cDh\xbe\x08q&{h\xa4\xe42I!\xf2 2\xa8\xa7\xb8\xd8k\x97\x8bg\xfa\xf9\xab\xecv\xf7T\xb6\xb7\x90\xf6\xefu\xb3\xb3\xa77\xbf\x97\x04\"\xb6ofoo\xd5\x0f\xe1\xcf^7\xaa\xcf\xd0\x10\x1b\xde85Z\x10\x1d\xa1\xe2\x93m\x9ed\xbc\x1d\xf9\xf5\xc2\x8a\x03=~\xc8\xd4\xb1\xc94\x1e\x16\x16\x1c\xac\xf0\xf2YM'\xab\x93a\xc9\xc4K\xdc\xb7\x0d\x95\x92\xbc\xf47d\x05J\xba\x02\xf4\xfa\x97_1\xc8ZD\xc4\xef\xc8i\xc84\xf3\xe1\x17\xe197L\x89P$\xb1Q(?\xab\x93\xa2\xcc\x9b\x00K\x0d#\x17\x81}\xb2\x96\x81\x8bs\xcc \nHA\x01\x1e`\x06Q@\n\n\xf0\x003\x88\x02RP\xa9D<`\x17m )I&[sK\xc3n_gV\x90\x8b\xa4DtK\x16\xe2. j\xe5\xe9\xbc_AT\xd5\xa5+<N\xb4c\xf9\xdc\xe5ha\xc4t\xc1\xbf\xc9\x1e\x8e\x9fM\xe3\xbfq,I\xd3\xd3\xaa\x8eJI	\x0d\xfb\x16\xa3\xa1a\x14\xd1\x82\x1f\xf5\xe6\xec\x85\xeb\xc7\xd9\xda\x99\xd6\xd7\xf1D#\x0b\xc1J\xdeg\xe9\xc7\xe9\xfe\x18\xf4\xd4<\xb1\xf1\x0b,\xf4\x17(\xd1\x1eY\x03\x1a\xee\x96\xa63\x9e\x85\xa3G\xe67$\xcb\xfb\xc4:u\xbf\xb5|\xd7\x02t\xea\xadv\xb7\x823\xb8\xa5?=\xc2\xa3\x8f\xc4M\xd7R\xffsb)\xc7\xac7a\xe3R\x95\xfd>3k%\xab\xe5Z\xe1f$\x0dU\xd5\xbaH\xa9\\hv\x16\xa0\x14;a\xcf\xbf\x80C\x86=>\xee\x1f\xd1t\x93\x0bY\xcf\x9a\xba|\x87	\xee\x00E\x00\x87 1\xc1\x1d\xa0\x08\xe0\x10$&\xb8\x03\x14\x01.\x13\x04)\xcf\x92~\x16\xba4^\x9cv[\xe3zY\xd7P\xec\xeb\xcd\xc8\x0e\xf1(n\xfa\x82\xd5\xb4\xb2k\xc59b\xd6_&\xe0\x7fEe$\xe1I\xe2_RxP+\x1a3cU5\x7fbRQ\x9bY\x9aUGq\xfb{\x92\x81\xd7~\x94\xacu\xb5\xb9\x83\xa4_\xa9\xb0\xa2\x01\xf9\xaa}\xa9E\x81G\xec37 \xe8\xfb]\xbfhD\x7fa\x8a1\x10h\xb0jJ\x88\xf4\xe7\xfc\xd1\xca\xd5j\xb3\x98\x9e\xf9\xd6$;Ruit\xda\x15\xeb\x0c\xb1M\xe2;ehC\xf3dt\xf7]\x99\xbc\xb4\x8cYv\xe3\xad1\xdec\xe2\xbb\x03\x0c\xc1\xaem\x9a\xc2\xf4w bF\xc5\x17\xb2\x07b\xc7\xf3\xf7\xd1\xfc\xd0+\x86\x80<IBL\xe2\x96\x0b\x0c<\xc0\x0c\xa2\x80\x14\x14\xe0\x01f\x10\x05\xa4\xa0\x00\x0f0\x83(@\xa2\x00E\x96\xd6B|\xf6\x17u%\x9dO\x8f<\x8c\xae\xf7/\x98DY\x9a\xdf,*\x12\x0f\x07\x9c\x95\x93\xd8\x98\xa2N\xc5\x9cL w\xe6d\xed\xe9\xef\xbc\xafq\x93DK\xdef\x81\xf7\x19>\xd0\x99U\x0d[\xc2\xcfq:'\x1dF\xfd\xfc\x1f\xdb\xce\xa1\xfd\xf5\xf6\xb2+\xb3n!\xe1B\x1d\x13\x9b\xbah\x0e\x8a\xb0\xaaUQ\x88\x91\xd5-~I\xfa\x80\xde\x10~\xcd\xd9\x12#Q\xbb_8J\xfc\xe4\x89\xbb2\xa1\x07\xbd\xee\xfa\xcfR`\xb2\xd9\x81a\x8bq\xbe\xd0Vn4p\xb5\x1cy\xf2\xc4=\xb7\xf5\x8d\xb1\xcc\x88\xd8gx\xdc\xae@\xdb\xcbE`\x1b\x06T\x0bP\xedG\xaf\xad\x1b\xdf}\xda\xa8\xa4\xe2\x13\xa3p\xd8\xd4'\xa1~F\xaf\xbb\xc6\xb97\xc8\xc0\x82\x81\xc8\xfc\x83\xf6?\x89Cu\xfc$n9\x90\xc2%s\xf2\x15\x8f\x96\x82\x93\xe0bB\x03Ht\x13[\xfft\x17D\x8a\xd7\xbf\xa6\xcf6\x1b\xb8\xef\xfa\x0e\xb7\xfea\xdf\xeeF\xbb\xaay\xe3<\xa2\xa7\x93\x86\xb1Zg8\x13Z;G\xa4\x17OR4U\xb49\x96\xe1\xeb\x89\xbb\xb9>\xbc\xdc\xb4\xdac\xda\xf59\xcbf\xb3\xa6\x7fp\x90\xb5\xd9\x93\xf4!Y\xaf\xd9\xaf\x9c#\x13!\xb9\x8bg\x05\xdf\xf4\x93\xbfD`\xf4qk/G\xf3\x10\xfc\x10\xa8\xaefR\x08\xa7\xae\x85\xb5\xef\xd7\xa0\xf3\xaf\xf7\xba\x0b$\x8d\xeb^Kd\x9b)K\xd0\x8b\x0d\xc1v\xa8\xee\x9c\x8a}\x9e%N\xa9E\xe7;\xdet\x7f2\x1f\xe6\xf2\xd1^\x95\x9e\xb2\xf2\x8fz\x80\xd7\x83K\x17A+\x1aA\xc9M\xdbs=\xb6\xff\xdc|\x8d\xcc_\"\"<g}i\x19\xa7z\xd9)tV(\xda\x11\x93\xb8\xf4\x14]2k* tk\xf6mt\xae\x03\x0d?\xc6\xf1\xa7/$\x00n\x90Ln0\x8e\x85l\x04\xea\x94`\x0b*e\x08\n	\x80\x1b$\x93\x1b\x98\x8ec\xb5\xaf\xee\xb86\xd9lq?a76\x9e\xac\xad1\x9eH++O\x90\x1c\\\x1c_z\xd1\xe4\xf2s<\x8fB\xef\x98\xd5\xe7yT\xce]Ib\x88\xa7\x91\xfas1&L\xc8Q\xab$\x03Q\x04\x8c\xb6\xb0<\xb8\xbaV>-\xdeT\x06z\x87\xc1\x0b\xf3hP\xa1\xe1n\xa1w\xc9\xf0s\x1d4k\xe8kN\x91\x0fM\xd5V\xa6\xc7\x1b\x98\xa9b\x9e\xa0\x82\xcb\x87\x96\xef\xf0p\xa3\xf2\xf7\xee\xa6\xeb\x17Q\x9b-j\xc2\xbe)\"#\xfa\\\xb5\xdf\xc2\x8f\x10S{\xca\xe7B\x87#\xa3\xcb[\x9b\xc4}\x05\xa2B\x8f\x10\x93;\xb4\xa3\x94\xf4\xe4\x918^-\xaa\x86\xbc	\xf2\xc4\x12\x15\xaf;\xcf\xbfX\x8f\xb0oy\x13\x13x\xd3c\x17IF\x01)(\xc0\x03\xcc \nHA\x01\x1e`\x06Q@\n\n\xf0\x80)3H\xf5|\xab$\xfd\xbd\xdf\x00\xff\x17\x06oU\x98=(\xb5\x9a\xce+r\xf7>$\xbf\xb7\x8a\xd9\xb2\x14/\xa6\x89\xb9mK\xff\xcc\xdcz\xf8\x13'\x9a\xb1\x8f\xcaF\x10\xeegV\xcc\x03I\x19KN\x10\x15y\x19\xc2/\xb72?\x9a\xe3\xb9\xb4\xba\x8b\x98\x81\xaf\xf4\x0b\x9fv\x1c\x94\x10!\x92\xc4qx\"C\x9ehy\x8b\xb6		SNZ\xd8\xcat\xff\xc1\xdf?0\xfe\xd5\xaak\xf2\xcd\xb7\xcc\x84\x1e\x90q<\xc8<T\x97\x9c\xf0\xbb\x18'\xbf\xf7t\xb9/\xb0\xdbF\xa5A}yuYn/\x1e\xaa\xb4\xf7\xc8\xdfDgY\x8d\xcf\xffk\xd5\x9c\x9a\xf5\xcdR\xb4\xd8\xa7\x1b]1O\xb2\xd5\x13\x16\xb2d\x83\xb9\x0e\xf9'9\x17?\x9f\xd2\xd2\xb9\xf1\xbc\x8a\xa0\xc2\xa6\xb4\xbd{\xcf\xc2\xe0\xab\xe8\xb7\xc0G\x13\xc9\xea\xce\x93\xbd\xb2\xa6\xc4\xf4\xcdg\xf2\xbe\xf1?\x8cb\xefa\x12\x07\x0e2\x94\xb2M\x06}t\xb9+^.#4\x1dN\xb0\xd6\x8d\x87	\x0d\x90\x82\x93\xe0.R\x1d\xe8\x0b99\x9en\xa8\xe1/\xdc,\xdd\xc8\x13Xjh\x92\xebi\x04\xa9\xe7\x8b^\xd2\xcc5\x95\x9e\xbb\xa9Z]\x0e\xea\xc1s\xa7\x0e_;x\x98\x9cU\xad;\xb9O\xbc|\xb9\x19[i1\x83-\x98p}\x97M\x8d\xb3\xc7\xa0\xc9c9\xc9*#\xcec\x7f\xb6\xbb`1\x05f\x90\x14N\x80D\xed_\xe7.\xed\xca.\xbe\xa1\xa7\"\x96\xfe\x83\x91b\x10d\xd4\xbeH\x9dbzsb\xf3\xf3\xd7@\xf7\xe4\x8fK\xa7\xbd?\x83\x89Q\xb1\x85\xe5}.\xd7$@\xe6+\xba9\x83\xa2\xf9\xdao2\xfb}\xd5\x93Oz\xaf\x02\xe3\xf1HXnx\x1f\xd3\xd5i\xd2L\xcd\x9d[\x04N\x05\xb5\xa6+\xc2Ip1\xa1\x01R\xb8\x1c\x9f\xdf\xcbH\x08\x08h	\xfb\x8f\xb3v\xa4&\xf7=\xd7\xd1q\xd6\xef\xd9H\xaa8\xddO\xe8\x19J}d\x95\xfb\xe8\xa9\x86\xa7j\xbc\xfcS.\xcc\xa9\xbb\xfbA\xdc\n&\x10J\x83\x00\xdd\x8cZ\x1du\x8a\x0f:t\x92\xb0\xe6\xa3'\xb9\xbfI\x1b\xdel\xde\xaf\xb2\xf2\xc7\x89\x9b\x988\x1f0\xed\xc4\xf1\xc6\xe7\xedz\x1dKFF\x16wu\xe5\xb3\xf0\x00!\xf4eV\x9c\x01\xab\x1d=CsD2\x8b(\xca\x10\x83KJf\xb8\xc8\xbb\xdb\xbd\xa9m\xc4X\x0e\xf8\x13(\xe6\xfe\xabt&\xe0\x1c#%\x86\xf9[\x08\x1f\xb1t\xad5\xa6\x84\xe7\xc9#A\xf1\x93\xfb=j\xaa\x81\x9e4\x81\xb7$\xe7\x18\xab\xd2\xf7D\x05\xbcO\x15K\xe4[\xef\x12\x07~eh\xdc#h1\x8f)\x19<\xd2p\\\x8d!2Z\x95\x11\x87@\x03\xa4\xe0$\xb8\x98\x9d\xc2\xd4\xf3\x17'\xdb3\x81e\x1f\x1d_4\xac\xff0~\xc5y\xce\x17\xea7\x7f\xa7\xfd)\xfd\xd3\xf5K\x95\"\xac\x8e\xd4\xfdZ\xe1?\x06%\xb1C'kV\x85w\xa6\x1f\x7f\xcc.\x7f\x83/\x02){\x9e\xacq\x15\xf8\xf6\xd9P\xcc-\xb3Q8?\xab#\xed\x0d\xc96\xbf\xf9\x9f\xec6!g\x0e\xe1\x9f\xa6r\xf3Nu\xbb/\xcbKK\xc3\xaa^p\xa6\x80\xabyQe\xa4Xs\x95\xd3\xf0\xc2\xc9=\x02\xfa\xed\xa1\xc0l\xa7\x05]TkR0#q\x98U\xc4j\xac\xd4U\xd6\x9c\xb2\x1e\n;]64\x07\xa3\x92\x05\xe3g\xb9\x8e\xcb\xcb\xd08\xc7\xdf-\xd8\x11(\x7f\x9c\x03\x81}^\xc8\x05:\xf8\xbd\xf7\xe2\xb5e\x8f9\xe4`\xe92\xfdY\xc39\x86iT\x00\xc1\x9e\x134@\nN\x82\x8b\xd9YO2\xef%\xbf<\xe9BK\x06\xa1\xef\xd7o]9\xd9\xd1)Y]\xed1\x80\xbb\xe6\xf5\x97\xc8\x8fX\"\x9f[\xbcO\xb0|\x7fT\xab\xd0dmR\xe0\x1ai\xee`\xb10\xe1&\xff\xe5F\xacb\xa3\xfd\x08\x9f\xc7\xe9\x0e\xf1W\xce\xee\x93\xf9Q\xf3\x8b!I\xdf\xf6	\x16\xbd\xacq\xdd\xd5\xa2\x1a\xc2\x81\xb4K\x92\xbd\x8f\xe1\x1a\x91<\\\x1c\xf2=?\xc3\xce >\xc64l/\xe8\xfdbk\xcd\xd6\xfc-$\xdbl\xe4x\xc4\x9c>\xe6\xe2V\xf1\xe3z\xb2r\xc9+(\xb4y\xbe\x88\xaa\xf5\x917\xe2\xb9i7\xfa<\xe4\xd2|\xbf\xff\xaa\xf61\x86W\x1a\x88\xfc\xdaiq\xda+}O\x91\x9eS\x97\xf5\x91G\x17\x03\x85\xb2a\xe5\xca\x89\xdepED\x13\x1b\xf1\xc2\x97\xe0)_i\xa1\x90\xb0\xa6?\xbd\x98\xd0\x00)8	.\xe6;|X\xe2\x03\xf0\xe4\x05\x17\x1f\xc5#qI\xfb\xc1\xd9n6b	\x84\x04#\x17]\xcf2:+r\xcb\x86\xab\xc7eJ&\xf5\xe6\x06\xae\xe3\xf58\xa2\xe9F\x80\x02Z\xa4\xf4\x9d\xdb\xeaajxx!\x1b[O\xef\xfe\xf5\xf0\x1f\x16\xef\x99\xacc2\xf9\xd5\xe7\xcd_.\xf7UX\xb4\\\xcd\xcdc,8\xea\x0d}\xc4\xf7O\x0c\xfd\xcan\x13_\xc1\xad+\x02\x97\x19\x93\x0f\x0f~\xd4nS\x14\x0c\xa5\xc7\xa4F\x95\x1ff\xd6\xbeX\xbd\x96i\x91\xda\x89\xf8\xad\xeep\xa9ua\xac\xcary\xa4n\xe4\xd0\x948\xc5@\xffj\xf0\x8eS\xd7:qI~\x99a\x13\xc2r(P7C\x92\xea\xfe\x04\xd1\xb1\xf8\xd3\x86\xbbl\x1e\xb18\x83\x0c\xf1\xfb\x0b\x8f&\xec\x84R\x9a<Y\xdb\x12\x13\x12\xea\xe7*\xd6\x0c\x8d\xdf\x86X\xde81\xd1\xbf{T~\xd9V\x1f\x87z\xe5=\x93\xe5\xed\xc0Cl\x91\xf5\xf9\xd6j\x1f\xe6\x15\xf3\xba\x13\x81\x94l\xea\xdf\xabkv\xb4Qn\xaf3\xd2/y\xe2\x15\x15c$\xa6\xde\xd60\x11\xc3\xd7A<\xb2\x91\xf5h\x92L\x85\xe0\x11\x84\xeb\x15\xae\xa0\x97\xb49\x89\xf9\xefKIF\xc2\x84\x07o\x13\xc5\x07\x1c\xd1\x03\x8eaU\x0c\x92\xecy\xb1\x9a\xcf\xd8jk\x8aC\xa8\x86{\xad\xf3.\x05\xef\xa7\x8b\xfb\xf8\x8d\xff\xd8u\xa5\xb7\xffa+\x1eR<\xc3\xb9\xe5\x95\xbc\xfe6~\x08i\xdcL\xf1\xf4Vi(\xf4\xbexZj\xdau\xba0u\xf5\x19g\xf8\xd1\xfd\xaa\xfb\xe2\xbc\xe9=\x83?\x95\xc2*\x9e\xf0\xd1\x87\xdf\xe7\xe9w\x9da\x15\x16\xca\xd6\x98\x8a^\x93W.\x10>t\xe3O\x8a@\xa1w\xbcx\xa9\x8c|E\xfc=&\xf73R\xe9\x19\n\x0cg\x8d\x15\xba\xb6kN\x93\xfc\xed\xf5S\x7f\xa49\x06\xfb\x9d\xfe\x10\xb1\x9eaql\x9bE	\xfa}\xbapr\x1a\xdf\xde\xa3u\x87\xd5\xcd\xee^7;,\xe8y0H\x18\xbf|i\x98\xee\xef#\xb9\x8fN	\xf1<A]\xaa\xe73a\xac\xaf\xda\xf01\x9cz\xb5\xec]\x0f-\xdf\xdc\xeeN:|\xf9\xf2\xc5P\xfbdG\xd8\xc8\x80\x01;p\xd92\x99\xd8\xf1\xf2a\x01O\xfc\x10\xea\xa9\x9c\xcf\x0bg\x06\x87\xfd\xd0\x16\xa3}\xe7-\xb4\xff\xee\xa4\xea\x97\x99y\x07m\x9d\xba!\x89\xf9\x05J\xc9}t\xf1\xaf\xc0\x90\x90\x90\x90\x90\x90\x90\x90\xab\xdd\x9f%\xadp\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xffco\x16\xe9O\x0bZ+\xcdq0\x00\x00\xb8]We\xdeA\xcecT\xe0\xaf\xfe\xa1B\"\x00-\xe8)\xa7#\x04\x00\xc0I\x8e\xea\xf7H{\x06\xcd1\x01D\n\x90\x80\xbf\xe5\x7f\\\x91z	^\x820:Q*\x00\x80\x14\xfc\x97\xe3\x0f\xa6\xb0\x94\x9e\x7f\x8b\xfb\xaf\xfeY\xea\x82,\x01^\xf7]\x93\x7f]\x07$\xb6\xb9E\xf2:\xd03\x01\\\x00E\x02\xf8\xdf\xf2?\xae\xac@\x97\xa0&;\xd3\x1d\xff\x8a\x13\xca\xe7\xbfMo:/\x88\xfd\xbf\xb2\xfe\xab\x7f\x9a\xe00\x80\x83\xac\xb4\xfd\xf7Y\xe5\x88\xea\xbfo\xb2\x7f\xdd?\xd3\x91P\x02J`\xde\x0b\xfb\xd7\x13G\x12\xf2\xc7\xe0\x0cw5\x9f\xef\x7f\xa7\xfdW\xff09\x03\x04\x083\xfc/\xb3J\xef\xffWw\xfd\xeb\xfe\xc9\x0e\xd9\x00\x1a\x00\x95\xa8;\xef\xbf^\x02\xa5\x06\x16\x1b\x9fP	\x87C\xfe=\xef\xbf\xfa')\x04\xa3\x1db\xb23\xfb\xef\xb3\x8a\x98\xc2\x7f\xdfd\xff\xba\x7f\xa6\xeb\xc2j\xc7j\x9f\xae\x0c\xfc\xd7\x13\x87iv3)\xde\xd9`M\xf8\x9fa\xff\xd5?LR\xb4\x00\x06\xcc\xa7\xfe}V\xf9\xc5\xf7\xdf7\xd9\xbf\xee\x9f\xe9\xe0\xdc\x80\x1b\xf4D\xfc\xfb1\x9a\x8a\xf9\xbf\xe7\xfdW\xff(\xad\x00'@\xd5\xf4_f\x95H\xd2\xff\xbe\xc9\xfeu\xffL\xe7\x0cq\x82\xf0\xba\xef\xfc\xfb1\x1a5\xc6\xff\x13\xf5_\xfd\xe3D\x82\x83\xc4h\x9f\xae\xfd\xf7Y\xe5m\xd8\x7f\xdfd\xff\xba\x7f\xa6\x0b!@\x12 \xff\"\xbf\xbf\xc8\xef/\xf2\xfb\x8b\xfc\xfe\"\xbf\xbf\xc8\xef/\xf2\xfb\x8b\xfc\xfe\"\xbf\xbf\xc8\xef/\xf2\xfb\x8b\xfc\xfe\"\xbf\xbf\xc8\xef/\xf2\xfb\x8b\xfc\xfe\"\xbf\xbf\xc8\xef/\xf2\xfb\x8b\xfc\xfe\x8fC~_\xff\x15.\xeeQ\xfdP\x8e\xe1\xea\xbf\xc7\xfd\xff[K0@\x04\xf6\x95\xe7\xc8\xa5MG\xdd\xfe\xf5\xc9SyU\xb9r\x19\x93w\xff\xd7\x00PK\x07\x08\xd8\x8cJ\x15g5\x00\x00\xeee\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x0d\x00	\x00sfx/jump.jsonUT\x05\x00\x01\x80Cm8L\xcdA\xaa\xc20\x10\xc6\xf1}N1\xcc\xfa\x11\xa6y(%k\xf1\x02\x9e`\xb4\xa3\x06S[\xd31\n\xe2\xdd%\x89\x0b\x97\xdf\x8f?|/\x03\x80\x0f\xce\x82\x1ePS\xe0\xeb)\n\xfe\x15=&\xb9\xa1\x07\xe7\xa8\xce%\x86\xa1T]\xdf\xf6\xc8\xcfm+\xfa\xaf\xe4\xb0O\xac\xd3Ff=\xa3\x07\xb2\xe4~}7\x8b\x0c\xe8a]\x91U\xf9piYW%I\x14^\xca\x05YZU\xcaS\xbc\x8f\x82\x1e\xc8\xfe\x9b\xb7\xf9\x0c\x00PK\x07\x08B\xe9;y\x7f\x00\x00\x00\xad\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x0d\x00	\x00sfx/land.jsonUT\x05\x00\x01\x80Cm8L\xccA\n\xc20\x10\x85\xe1}N1\xccZ\xc3\x18P$\x07\xf0\x1e\xc1>5\x90\xa6\xd8i+\"\xde]2*t\xf9~>\xde\xcb\x11\xf1#-\xe0H\\\x87\xac\xe0MK\x97\x11w\x8e\xb4\x0b\"\xb6\xb5\xe4\xae\x99\xedA~\xa5\xcf\xf5\xf4E\x7f\xd3\xe1\x9c\x9e\x1cI\xbc\x1c-\xe8\xacS\xca\xb5%\xdb\x05\xf5:\xdd\xd6bDA\xd2v,^\x82\xa1e(s\x0f\x8e$>\xec\xdd\xdb}\x06\x00PK\x07\x08Vz\xf1Ht\x00\x00\x00\xa2\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x0f\x00	\x00sfx/pickup.jsonUT\x05\x00\x01\x80Cm8L\xcdK\n\xc20\x10\xc6\xf1}N1\xccZ\xc3\xc4g\xc9\x01\xbc\x80'\x18\xec\xa8\x85\xb4\xda<Z\x8bxwI\xb2\xe9\xf2\xfb\xf1\x87\xef\xab\x00p\xe6I\xd0\x02\x861\xb1\x17\xdcd\xbb{\x19\xd1B\xd3P\x99\xc1umn\x0eD\x15z\xfe\\jb\xce\xa7Jm\x8a\xcbu\x16y\xa3\x85\xad\xa9$7^\xd0\x02is,\x10R\x88\xdc\x0d\x85\xf6E\x9c\x0c\x8f\xf8\\7^\x9cp\xc8o\xa4\xa9\xd2\xf4r\xa9\x17\xb4@z\xa7~\xea?\x00PK\x07\x08\xf5\xf9\x10\xa1\x81\x00\x00\x00\xb6\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x0f\x00	\x00sfx/record.jsonUT\x05\x00\x01\x80Cm8$\xcd\xd1\n\xc20\x0c\x85\xe1\xfb>E\xc8\xb5\x94\xc3\xa8\"}\x9b\xb0E\x10\xa7\xb26\x99\x88\xf8\xeec\xd9\xe5\xffq\xe0\xfc\x12\x11\x7fdU\xae\xc4}qi\xca\xa7\xddnM\x17\xaeT\n\"'\xb7/WB\x1e\xce\xd1b&\xe3#\x048h\xd2Q\x8e\x0d\xae\x01\xdd\xbb\xc9\xfd\x15t	i:\xab\xf4\xfd\x0b\x19%h}\xcf\xfeT\xae\x84<\xa4\x7f\xda\x06\x00PK\x07\x08s\x03z\xe5j\x00\x00\x00\x90\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x10\x00	\x00sfx/wipeout.jsonUT\x05\x00\x01\x80Cm8L\xce\xc1\x0e\x820\x0c\xc6\xf1;O\xd1\xf4\xac\xa4\x88\\v6\xbe\x80OP\xa5\xea\x921p\x1b\x10c|w\xb3\x8e\x83\xc7\xef\x97\x7f\xb6~*\x00\\y\x114\x80\x91W\xdce\xb8\x07y\xa1\x81\xb6%\x9d\xd1\xd9>\x07\xfb\x8e\n\x0c\xd6\x9fK\xd2u\n\x8b\xbd\x06N\xe3I\xa6\xf4D\x03T7\xff|\x99Dz4\xd0\x1cT{\xb9\xf1[\xab\xa3\xee8\xc7\xc4\xd6g\xd1\xed\xc4?\xb6gJ\x10\xc4	\xc7|\x01\xd5\xb4}8\xbay\x104@u[}\xab\xdf\x00PK\x07\x08\xa8\xe98\xa3\x88\x00\x00\x00\xc7\x00\x00\x00PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xa5\xc3\xbc\x15\xc6\x00\x00\x00\x9d\x01\x00\x00\x11\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x00\x00\x00\x00achievements.jsonUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(n\\\x06\x89\xe4\x00\x00\x00F\x03\x00\x00\n\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x0e\x01\x00\x00atlas.jsonUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xd8\x8cJ\x15g5\x00\x00\xeee\x00\x00	\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x813\x02\x00\x00atlas.pngUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(B\xe9;y\x7f\x00\x00\x00\xad\x00\x00\x00\x0d\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xda7\x00\x00sfx/jump.jsonUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(Vz\xf1Ht\x00\x00\x00\xa2\x00\x00\x00\x0d\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x9d8\x00\x00sfx/land.jsonUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xf5\xf9\x10\xa1\x81\x00\x00\x00\xb6\x00\x00\x00\x0f\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81U9\x00\x00sfx/pickup.jsonUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(s\x03z\xe5j\x00\x00\x00\x90\x00\x00\x00\x0f\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x1c:\x00\x00sfx/record.jsonUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xa8\xe98\xa3\x88\x00\x00\x00\xc7\x00\x00\x00\x10\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xcc:\x00\x00sfx/wipeout.jsonUT\x05\x00\x01\x80Cm8PK\x05\x06\x00\x00\x00\x00\x08\x00\x08\x00$\x02\x00\x00\x9b;\x00\x00\x00\x00"
 	fs.Register(data)
 }
@@ -0,0 +1,174 @@
+package game
+
+import (
+	"image/color"
+	"strconv"
+
+	"github.com/hajimehoshi/ebiten"
+	"github.com/hajimehoshi/ebiten/ebitenutil"
+)
+
+const (
+	storageKeyStats = "stats"
+
+	// The jump heights are counted in the bins of heightBinSize. The last
+	// bin has all the heights above.
+	heightBinSize = 500
+	heightBinNum  = 10
+)
+
+// LifetimeStats are the totals over all the sessions, which are used to
+// discuss balance changes.
+type LifetimeStats struct {
+	Jumps     int     `json:"jumps"`
+	Distance  float64 `json:"distance"`
+	Airtime   float64 `json:"airtime"`
+	BestCombo int     `json:"bestCombo"`
+	Runs      int     `json:"runs"`
+
+	HeightSum       float64 `json:"heightSum"`
+	HeightHistogram []int   `json:"heightHistogram"`
+
+	storage Storage
+	// runLandings is the number of the landings in the current run, which
+	// tells an empty run from a played one.
+	runLandings int
+}
+
+func NewLifetimeStats(storage Storage) *LifetimeStats {
+	s := &LifetimeStats{storage: storage}
+	loadJSON(storage, storageKeyStats, s)
+	if len(s.HeightHistogram) != heightBinNum {
+		h := make([]int, heightBinNum)
+		copy(h, s.HeightHistogram)
+		s.HeightHistogram = h
+	}
+	return s
+}
+
+func (s *LifetimeStats) AverageHeight() float64 {
+	if s.Jumps == 0 {
+		return 0
+	}
+	return s.HeightSum / float64(s.Jumps)
+}
+
+func (s *LifetimeStats) OnEvent(e Event) {
+	switch e.Type {
+	case EventLand:
+		height := e.Stats[StatHeight]
+		s.Jumps++
+		s.Airtime += e.Stats[StatAirtime]
+		s.HeightSum += height
+		bin := int(height) / heightBinSize
+		if bin >= heightBinNum {
+			bin = heightBinNum - 1
+		}
+		s.HeightHistogram[bin]++
+		if c := int(e.Stats[StatCombo]); c > s.BestCombo {
+			s.BestCombo = c
+		}
+		s.runLandings++
+	case EventRunEnd:
+		distance := e.Stats[StatDistance]
+		played := s.runLandings > 0 || distance > 0
+		s.runLandings = 0
+		if !played {
+			// Restarting before moving is not a run.
+			return
+		}
+		s.Runs++
+		s.Distance += distance
+		// The stats are saved once per run rather than on every landing.
+		saveJSON(s.storage, storageKeyStats, s)
+	}
+}
+
+// BarChart is an ElementImpl which shows values as horizontal bars with
+// their labels.
+type BarChart struct {
+	Labels []string
+	Values []int
+}
+
+const (
+	barChartWidth   = 320
+	barChartSpacing = 2
+	barInset        = 2
+)
+
+func NewBarChart(labels []string, values []int) Element {
+	return NewElement(&BarChart{Labels: labels, Values: values})
+}
+
+func (c *BarChart) Draw(screen *ebiten.Image, x, y, w, h int) {
+	labelW, rowH := c.labelSize()
+	max := c.max()
+	// Leave space for the text of the largest value after the bars.
+	valueW, _ := textSize(uiFont, strconv.Itoa(max))
+	padding := dp(widgetPadding)
+	barX := x + labelW + padding
+	maxBarW := w - labelW - valueW - padding*2
+	for i, v := range c.Values {
+		ry := y + i*(rowH+dp(barChartSpacing))
+		drawText(screen, c.Labels[i], uiFont, x, ry, rowH, color.Black)
+		barW := 0
+		if max > 0 {
+			barW = maxBarW * v / max
+		}
+		ebitenutil.DrawRect(screen, float64(barX), float64(ry+dp(barInset)), float64(barW), float64(rowH-dp(barInset)*2), widgetActiveColor)
+		drawText(screen, strconv.Itoa(v), uiFont, barX+barW+padding, ry, rowH, color.Black)
+	}
+}
+
+func (c *BarChart) Size() (w, h int) {
+	_, rowH := c.labelSize()
+	spacing := dp(barChartSpacing)
+	return dp(barChartWidth), len(c.Values)*(rowH+spacing) - spacing
+}
+
+func (c *BarChart) OnClick() {}
+
+func (c *BarChart) labelSize() (w, h int) {
+	_, h = textSize(uiFont, "0")
+	for _, l := range c.Labels {
+		if lw, _ := textSize(uiFont, l); lw > w {
+			w = lw
+		}
+	}
+	return w, h
+}
+
+func (c *BarChart) max() int {
+	max := 0
+	for _, v := range c.Values {
+		if v > max {
+			max = v
+		}
+	}
+	return max
+}
+
+func (g *Game) newStatsMenu() *Menu {
+	s := g.stats
+	labels := make([]string, heightBinNum)
+	for i := range labels {
+		labels[i] = strconv.Itoa(i * heightBinSize)
+	}
+	labels[heightBinNum-1] += "+"
+
+	back := NewButton(tr("menu.back"), g.closeMenu)
+	content := NewVBox(menuSpacing,
+		NewLabel(tr("menu.stats")),
+		NewLabel(tr("stats.runs", s.Runs)),
+		NewLabel(tr("stats.jumps", s.Jumps)),
+		NewLabel(tr("stats.distance", int(s.Distance))),
+		NewLabel(tr("stats.airtime", s.Airtime)),
+		NewLabel(tr("stats.bestCombo", s.BestCombo)),
+		NewLabel(tr("stats.avgHeight", int(s.AverageHeight()))),
+		NewLabel(tr("stats.jumpHeights")),
+		NewBarChart(labels, s.HeightHistogram),
+		back,
+	)
+	return NewMenuWithContent(content, back)
+}
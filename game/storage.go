@@ -0,0 +1,80 @@
+package game
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"sync"
+)
+
+// ErrNotFound is returned by Storage.Load when nothing is saved for the key.
+var ErrNotFound = errors.New("game: not found")
+
+// Storage is a key-value store which keeps records and settings between
+// sessions. Each platform has its own backend.
+type Storage interface {
+	Load(key string) ([]byte, error)
+	Save(key string, data []byte) error
+}
+
+const (
+	storageKeyRecords  = "records"
+	storageKeySettings = "settings"
+	storageKeyBindings = "bindings"
+)
+
+// loadJSON decodes the value for key into v. v is left as it is if nothing is
+// saved or the value is broken.
+func loadJSON(s Storage, key string, v interface{}) {
+	data, err := s.Load(key)
+	if err != nil {
+		if err != ErrNotFound {
+			log.Println(err)
+		}
+		return
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		log.Println(err)
+	}
+}
+
+func saveJSON(s Storage, key string, v interface{}) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	if err := s.Save(key, data); err != nil {
+		log.Println(err)
+	}
+}
+
+// memoryStorage keeps values only while the game runs. It is used when no
+// other storage is available.
+type memoryStorage struct {
+	values map[string][]byte
+	m      sync.Mutex
+}
+
+func NewMemoryStorage() Storage {
+	return &memoryStorage{
+		values: map[string][]byte{},
+	}
+}
+
+func (s *memoryStorage) Load(key string) ([]byte, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	data, ok := s.values[key]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return data, nil
+}
+
+func (s *memoryStorage) Save(key string, data []byte) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+	s.values[key] = data
+	return nil
+}
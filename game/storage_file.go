@@ -0,0 +1,87 @@
+//go:build !js
+// +build !js
+
+package game
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+const (
+	configDirName   = "osushi"
+	storageFileName = "storage.json"
+)
+
+// fileStorage keeps all the values in one JSON object in a file.
+type fileStorage struct {
+	path string
+	m    sync.Mutex
+}
+
+// NewFileStorage returns a Storage which writes to the file at path.
+func NewFileStorage(path string) Storage {
+	return &fileStorage{path: path}
+}
+
+// NewDefaultStorage returns a Storage which writes to a file under the user
+// config directory.
+func NewDefaultStorage() (Storage, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return nil, err
+	}
+	return NewFileStorage(filepath.Join(dir, configDirName, storageFileName)), nil
+}
+
+func (f *fileStorage) read() (map[string]json.RawMessage, error) {
+	values := map[string]json.RawMessage{}
+	data, err := ioutil.ReadFile(f.path)
+	if os.IsNotExist(err) {
+		return values, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &values); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+func (f *fileStorage) Load(key string) ([]byte, error) {
+	f.m.Lock()
+	defer f.m.Unlock()
+
+	values, err := f.read()
+	if err != nil {
+		return nil, err
+	}
+	v, ok := values[key]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return v, nil
+}
+
+func (f *fileStorage) Save(key string, data []byte) error {
+	f.m.Lock()
+	defer f.m.Unlock()
+
+	values, err := f.read()
+	if err != nil {
+		return err
+	}
+	values[key] = json.RawMessage(data)
+	out, err := json.MarshalIndent(values, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(f.path), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(f.path, out, 0644)
+}
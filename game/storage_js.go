@@ -0,0 +1,45 @@
+//go:build js
+// +build js
+
+package game
+
+import (
+	"errors"
+	"syscall/js"
+)
+
+const localStoragePrefix = "osushi."
+
+// localStorage keeps values in the localStorage of the browser.
+type localStorage struct {
+	v js.Value
+}
+
+// NewDefaultStorage returns a Storage backed by the localStorage of the
+// browser.
+func NewDefaultStorage() (Storage, error) {
+	v := js.Global().Get("localStorage")
+	if v.Type() != js.TypeObject {
+		return nil, errors.New("game: localStorage is not available")
+	}
+	return &localStorage{v: v}, nil
+}
+
+func (l *localStorage) Load(key string) ([]byte, error) {
+	v := l.v.Call("getItem", localStoragePrefix+key)
+	if v.Type() != js.TypeString {
+		return nil, ErrNotFound
+	}
+	return []byte(v.String()), nil
+}
+
+func (l *localStorage) Save(key string, data []byte) (err error) {
+	// setItem throws when the storage is full or disabled.
+	defer func() {
+		if r := recover(); r != nil {
+			err = errors.New("game: failed to write to localStorage")
+		}
+	}()
+	l.v.Call("setItem", localStoragePrefix+key, string(data))
+	return nil
+}
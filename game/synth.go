@@ -0,0 +1,161 @@
+package game
+
+import (
+	"errors"
+	"io"
+	"math"
+	"math/rand"
+	"sync"
+)
+
+// noiseSeed makes the noise the same every time an effect is played.
+const noiseSeed = 1
+
+// Synth plays a sound effect as a note. It is triggered by seeking to the
+// start, and fades out by its envelope after Release or the length of the
+// effect.
+type Synth struct {
+	sfx  *SFX
+	freq float64
+	env  Envelope
+
+	// m guards the state below, since the samples are read on the audio
+	// goroutine.
+	m          sync.Mutex
+	pos        int64
+	releasePos int64
+	phase      float64
+	noise      *rand.Rand
+	noiseValue float64
+}
+
+// NewSynth returns a synth of sfx played at freq instead of its own
+// frequency if freq is not 0, e.g. for the notes of a melody.
+func NewSynth(sfx *SFX, freq float64) *Synth {
+	if freq == 0 {
+		freq = sfx.Freq
+	}
+	s := &Synth{
+		sfx:  sfx,
+		freq: freq,
+		env:  sfx.envelope(),
+	}
+	s.reset()
+	return s
+}
+
+func (s *Synth) reset() {
+	s.pos = 0
+	s.releasePos = -1
+	s.phase = 0
+	s.noise = rand.New(rand.NewSource(noiseSeed))
+	s.noiseValue = s.noise.Float64()*2 - 1
+}
+
+// Release starts fading out the note from the next sample to read.
+func (s *Synth) Release() {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if s.releasePos < 0 {
+		s.releasePos = s.pos
+	}
+}
+
+// frequency returns the frequency at t seconds from the start.
+func (s *Synth) frequency(t float64) float64 {
+	f := s.freq + s.sfx.Slide*t + s.sfx.DeltaSlide*t*t/2
+	if s.sfx.MinFreq > 0 && f < s.sfx.MinFreq {
+		f = s.sfx.MinFreq
+	}
+	if s.sfx.MaxFreq > 0 && f > s.sfx.MaxFreq {
+		f = s.sfx.MaxFreq
+	}
+	if f < 0 {
+		f = 0
+	}
+	if s.sfx.VibratoDepth != 0 {
+		f *= 1 + s.sfx.VibratoDepth*math.Sin(2*math.Pi*s.sfx.VibratoSpeed*t)
+	}
+	return f
+}
+
+// oscillate returns the sample of the waveform at the phase in [0, 1).
+func (s *Synth) oscillate(t float64) float64 {
+	p := s.phase
+	switch s.sfx.Wave {
+	case WaveSquare:
+		duty := s.sfx.Duty + s.sfx.DutySweep*t
+		duty = math.Max(0.05, math.Min(0.95, duty))
+		if p < duty {
+			return 1
+		}
+		return -1
+	case WaveTriangle:
+		if p < 0.5 {
+			return 4*p - 1
+		}
+		return 3 - 4*p
+	case WaveSaw:
+		return 2*p - 1
+	case WaveNoise:
+		return s.noiseValue
+	default:
+		return math.Sin(2 * math.Pi * p)
+	}
+}
+
+func (s *Synth) Read(buf []byte) (int, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	length := int64(s.sfx.Length * sampleRate)
+	n := len(buf) / 4
+	for i := 0; i < n; i++ {
+		if length > 0 && s.releasePos < 0 && s.pos >= length {
+			s.releasePos = length
+		}
+		t := float64(s.pos) / sampleRate
+		val := s.oscillate(t) * s.env.level(s.pos, s.releasePos) * s.sfx.Volume
+		b := int16(val * math.MaxInt16)
+		idx := i * 4
+		buf[idx] = byte(b)
+		buf[idx+1] = byte(b >> 8)
+		buf[idx+2] = byte(b)
+		buf[idx+3] = byte(b >> 8)
+
+		s.phase += s.frequency(t) / sampleRate
+		if s.phase >= 1 {
+			s.phase -= math.Floor(s.phase)
+			s.noiseValue = s.noise.Float64()*2 - 1
+		}
+		s.pos++
+	}
+
+	return n * 4, nil
+}
+
+// Seek seeks to offset in bytes. Seeking to the start triggers the note
+// again, and seeking elsewhere keeps the phase of the oscillator.
+func (s *Synth) Seek(offset int64, whence int) (int64, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	pos := s.pos
+	switch whence {
+	case io.SeekStart:
+		pos = offset / 4
+	case io.SeekCurrent:
+		pos += offset / 4
+	case io.SeekEnd:
+		return 0, errors.New("SeekEnd: End of synth is not defined")
+	}
+	if pos == 0 {
+		s.reset()
+	} else {
+		s.pos = pos
+	}
+
+	return s.pos * 4, nil
+}
+
+func (s *Synth) Close() error {
+	return nil
+}
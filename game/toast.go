@@ -0,0 +1,50 @@
+package game
+
+import (
+	"github.com/hajimehoshi/ebiten"
+)
+
+const toastTicks = 180
+
+// Toast is an Element which shows messages one by one for a while.
+type Toast struct {
+	*Panel
+	label *Label
+	queue []string
+	ticks int
+}
+
+func NewToast() *Toast {
+	label := &Label{Color: widgetBorderColor}
+	return &Toast{
+		Panel: NewPanel(NewElement(label)),
+		label: label,
+	}
+}
+
+// Show queues msg after the messages being shown.
+func (t *Toast) Show(msg string) {
+	t.queue = append(t.queue, msg)
+}
+
+func (t *Toast) Update() {
+	if t.ticks > 0 {
+		t.ticks--
+	}
+	if t.ticks == 0 && len(t.queue) > 0 {
+		t.label.Text = t.queue[0]
+		t.queue = t.queue[1:]
+		t.ticks = toastTicks
+	}
+	if t.ticks == 0 {
+		return
+	}
+	t.Panel.Update()
+}
+
+func (t *Toast) Draw(screen *ebiten.Image) {
+	if t.ticks == 0 {
+		return
+	}
+	t.Panel.Draw(screen)
+}
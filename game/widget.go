@@ -0,0 +1,195 @@
+package game
+
+import (
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten"
+	"github.com/hajimehoshi/ebiten/ebitenutil"
+	"github.com/hajimehoshi/ebiten/text"
+	"golang.org/x/image/font"
+)
+
+// widgetPadding and the other sizes of the widgets are in logical pixels.
+const widgetPadding = 8
+
+var (
+	widgetColor           = color.NRGBA{0xee, 0xee, 0xee, 0xff}
+	widgetBorderColor     = color.Black
+	widgetActiveColor     = color.NRGBA{0x00, 0x99, 0x00, 0xff}
+	widgetBackgroundColor = color.NRGBA{0xff, 0xff, 0xff, 0xcc}
+)
+
+func textSize(face font.Face, t string) (w, h int) {
+	b, _ := font.BoundString(face, t)
+	m := face.Metrics()
+	return (b.Max.X - b.Min.X).Ceil(), (m.Ascent + m.Descent).Ceil()
+}
+
+// drawText draws t so that its bounding box starts at x and is centered
+// vertically in the area starting at y with height h.
+func drawText(screen *ebiten.Image, t string, face font.Face, x, y, h int, clr color.Color) {
+	b, _ := font.BoundString(face, t)
+	_, th := textSize(face, t)
+	ascent := face.Metrics().Ascent.Ceil()
+	text.Draw(screen, t, face, x-b.Min.X.Floor(), y+(h-th)/2+ascent, clr)
+}
+
+func drawFrame(screen *ebiten.Image, x, y, w, h int, fill color.Color) {
+	fx, fy, fw, fh := float64(x), float64(y), float64(w), float64(h)
+	ebitenutil.DrawRect(screen, fx, fy, fw, fh, widgetBorderColor)
+	ebitenutil.DrawRect(screen, fx+1, fy+1, fw-2, fh-2, fill)
+}
+
+// Label is an ElementImpl which shows a single line of text.
+type Label struct {
+	Text  string
+	Color color.Color
+}
+
+func NewLabel(t string) Element {
+	return NewElement(&Label{Text: t, Color: color.Black})
+}
+
+func (l *Label) Draw(screen *ebiten.Image, x, y, w, h int) {
+	drawText(screen, l.Text, uiFont, x, y, h, l.Color)
+}
+
+func (l *Label) Size() (w, h int) {
+	return textSize(uiFont, l.Text)
+}
+
+func (l *Label) OnClick() {}
+
+// Button is an ElementImpl which calls a function when it is clicked.
+type Button struct {
+	Text    string
+	onClick func()
+}
+
+func NewButton(t string, onClick func()) Element {
+	return NewElement(&Button{Text: t, onClick: onClick})
+}
+
+func (b *Button) Draw(screen *ebiten.Image, x, y, w, h int) {
+	drawFrame(screen, x, y, w, h, widgetColor)
+	tw, _ := textSize(uiFont, b.Text)
+	drawText(screen, b.Text, uiFont, x+(w-tw)/2, y, h, color.Black)
+}
+
+func (b *Button) Size() (w, h int) {
+	w, h = textSize(uiFont, b.Text)
+	return w + dp(widgetPadding)*2, h + dp(widgetPadding)*2
+}
+
+func (b *Button) OnClick() {
+	if b.onClick != nil {
+		b.onClick()
+	}
+}
+
+// toggleInset is the margin of the mark in the check box of Toggle.
+const toggleInset = 3
+
+// Toggle is an ElementImpl with a check box and a label, which switches its
+// value on every click.
+type Toggle struct {
+	Text     string
+	value    bool
+	onChange func(value bool)
+}
+
+func NewToggle(t string, value bool, onChange func(value bool)) Element {
+	return NewElement(&Toggle{Text: t, value: value, onChange: onChange})
+}
+
+func (t *Toggle) Value() bool {
+	return t.value
+}
+
+func (t *Toggle) SetValue(value bool) {
+	t.value = value
+}
+
+func (t *Toggle) Draw(screen *ebiten.Image, x, y, w, h int) {
+	_, th := textSize(uiFont, t.Text)
+	drawFrame(screen, x, y+(h-th)/2, th, th, widgetColor)
+	if t.value {
+		inset := dp(toggleInset)
+		ebitenutil.DrawRect(screen, float64(x+inset), float64(y+(h-th)/2+inset), float64(th-inset*2), float64(th-inset*2), widgetActiveColor)
+	}
+	drawText(screen, t.Text, uiFont, x+th+dp(widgetPadding), y, h, color.Black)
+}
+
+func (t *Toggle) Size() (w, h int) {
+	w, h = textSize(uiFont, t.Text)
+	return w + h + dp(widgetPadding), h
+}
+
+func (t *Toggle) OnClick() {
+	t.value = !t.value
+	if t.onChange != nil {
+		t.onChange(t.value)
+	}
+}
+
+// Slider is an ElementImpl to choose a value in [0, 1] by dragging its knob.
+type Slider struct {
+	value    float64
+	onChange func(value float64)
+}
+
+const (
+	sliderWidth     = 160
+	sliderKnobWidth = 8
+)
+
+func NewSlider(value float64, onChange func(value float64)) Element {
+	return NewElement(&Slider{value: value, onChange: onChange})
+}
+
+func (s *Slider) Value() float64 {
+	return s.value
+}
+
+func (s *Slider) SetValue(value float64) {
+	s.value = value
+}
+
+func (s *Slider) Draw(screen *ebiten.Image, x, y, w, h int) {
+	ebitenutil.DrawRect(screen, float64(x), float64(y+h/2-1), float64(w), 2, widgetBorderColor)
+	ebitenutil.DrawRect(screen, float64(x), float64(y+h/2-1), float64(w)*s.value, 2, widgetActiveColor)
+	knobW := dp(sliderKnobWidth)
+	kx := x + int(float64(w-knobW)*s.value)
+	drawFrame(screen, kx, y, knobW, h, widgetColor)
+}
+
+func (s *Slider) Size() (w, h int) {
+	return dp(sliderWidth), dp(fontSize)
+}
+
+func (s *Slider) OnClick() {}
+
+func (s *Slider) OnDrag(x, y, w, h int) {
+	knobW := dp(sliderKnobWidth)
+	s.set(float64(x-knobW/2) / float64(w-knobW))
+}
+
+func (s *Slider) Adjust(delta float64) {
+	s.set(s.value + delta)
+}
+
+func (s *Slider) set(v float64) {
+	if v < 0 {
+		v = 0
+	}
+	if v > 1 {
+		v = 1
+	}
+	if v == s.value {
+		return
+	}
+	s.value = v
+	if s.onChange != nil {
+		s.onChange(v)
+	}
+}
@@ -0,0 +1,263 @@
+// Package leaderboard is a client of the online leaderboard. It submits scores
+// and fetches the top lists over HTTP with JSON, which works on desktop, in
+// browsers through fetch, and on mobile. Submissions which fail are queued in
+// the storage and retried later.
+package leaderboard
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hiroebe/osushi/physics"
+)
+
+// Metrics and modes known by the server.
+const (
+	MetricHeight   = "height"
+	MetricLength   = "length"
+	MetricDistance = "distance"
+
+	ModeNormal = "normal"
+	// ModeDaily is the daily challenge, whose terrain is generated from the
+	// date.
+	ModeDaily = "daily"
+)
+
+// DateLayout is the layout of the dates of the daily challenges.
+const DateLayout = "2006-01-02"
+
+// DailyDate returns the date of the daily challenge at t, which changes at
+// midnight in UTC.
+func DailyDate(t time.Time) string {
+	return t.UTC().Format(DateLayout)
+}
+
+// DailySeed returns the terrain seed of the daily challenge of date.
+func DailySeed(date string) int64 {
+	h := fnv.New64a()
+	h.Write([]byte("osushi-daily-" + date))
+	return int64(h.Sum64())
+}
+
+const (
+	scoresPath = "/scores"
+	queueKey   = "leaderboard-queue"
+
+	maxQueueLen = 100
+	timeout     = 10 * time.Second
+)
+
+// Score is a submitted score. Replay is the inputs of the run which achieved
+// it, so that the server can verify the score. The server omits Replay in the
+// top lists.
+type Score struct {
+	Name   string `json:"name"`
+	Metric string `json:"metric"`
+	Score  int    `json:"score"`
+	Seed   int64  `json:"seed"`
+	Mode   string `json:"mode"`
+	// Date is the date of the daily challenge in ModeDaily.
+	Date   string          `json:"date,omitempty"`
+	Time   time.Time       `json:"time"`
+	Replay *physics.Replay `json:"replay,omitempty"`
+}
+
+// Storage keeps the queue of the failed submissions between sessions.
+// game.Storage satisfies it.
+type Storage interface {
+	Load(key string) ([]byte, error)
+	Save(key string, data []byte) error
+}
+
+// RejectedError is returned when the server refuses a score. A rejected score
+// is not queued since retrying it doesn't help.
+type RejectedError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *RejectedError) Error() string {
+	return fmt.Sprintf("leaderboard: score rejected (%d): %s", e.StatusCode, e.Message)
+}
+
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+	storage    Storage
+
+	// flushing serializes the submissions, and m guards the queue. The
+	// scores are posted without m, so that QueueLen doesn't wait for the
+	// server.
+	flushing sync.Mutex
+	m        sync.Mutex
+	queue    []Score
+}
+
+// NewClient creates a client of the server at baseURL. If httpClient is nil, a
+// client with a default timeout is used.
+func NewClient(baseURL string, httpClient *http.Client, storage Storage) *Client {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: timeout}
+	}
+	c := &Client{
+		baseURL:    baseURL,
+		httpClient: httpClient,
+		storage:    storage,
+	}
+	c.loadQueue()
+	return c
+}
+
+func (c *Client) loadQueue() {
+	if c.storage == nil {
+		return
+	}
+	data, err := c.storage.Load(queueKey)
+	if err != nil {
+		// Nothing is queued.
+		return
+	}
+	if err := json.Unmarshal(data, &c.queue); err != nil {
+		log.Println(err)
+	}
+}
+
+func (c *Client) saveQueue() {
+	if c.storage == nil {
+		return
+	}
+	data, err := json.Marshal(c.queue)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	if err := c.storage.Save(queueKey, data); err != nil {
+		log.Println(err)
+	}
+}
+
+// Submit sends scores to the server in order after the queued scores. If the
+// server can't be reached, they are queued and the error is returned. The
+// submissions are serialized, so it is safe to call from any goroutine.
+func (c *Client) Submit(scores ...Score) error {
+	c.flushing.Lock()
+	defer c.flushing.Unlock()
+
+	c.m.Lock()
+	c.queue = append(c.queue, scores...)
+	if len(c.queue) > maxQueueLen {
+		c.queue = c.queue[len(c.queue)-maxQueueLen:]
+	}
+	c.m.Unlock()
+	return c.flush()
+}
+
+// Flush retries the queued scores.
+func (c *Client) Flush() error {
+	c.flushing.Lock()
+	defer c.flushing.Unlock()
+
+	if c.QueueLen() == 0 {
+		return nil
+	}
+	return c.flush()
+}
+
+// QueueLen returns the number of the scores waiting to be sent.
+func (c *Client) QueueLen() int {
+	c.m.Lock()
+	defer c.m.Unlock()
+	return len(c.queue)
+}
+
+// flush posts the queued scores in order, and then drops the ones which are
+// sent or rejected and saves the rest. The queue changes only in flush while
+// flushing is held, so the scores done are at its start.
+func (c *Client) flush() error {
+	c.m.Lock()
+	queue := append([]Score(nil), c.queue...)
+	c.m.Unlock()
+
+	var rejected, err error
+	done := 0
+	for _, s := range queue {
+		e := c.post(s)
+		var r *RejectedError
+		if errors.As(e, &r) {
+			// Drop it and go on with the others.
+			rejected = e
+			done++
+			continue
+		}
+		if e != nil {
+			err = e
+			break
+		}
+		done++
+	}
+
+	c.m.Lock()
+	c.queue = c.queue[done:]
+	c.saveQueue()
+	c.m.Unlock()
+	if err != nil {
+		return err
+	}
+	return rejected
+}
+
+func (c *Client) post(s Score) error {
+	body, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	resp, err := c.httpClient.Post(c.baseURL+scoresPath, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	msg, _ := ioutil.ReadAll(resp.Body)
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return nil
+	case resp.StatusCode >= 400 && resp.StatusCode < 500:
+		return &RejectedError{StatusCode: resp.StatusCode, Message: string(bytes.TrimSpace(msg))}
+	}
+	return fmt.Errorf("leaderboard: server error (%d): %s", resp.StatusCode, bytes.TrimSpace(msg))
+}
+
+// Top fetches the best scores of metric in mode. date is the date of the
+// daily challenge in ModeDaily, and ignored otherwise.
+func (c *Client) Top(metric, mode, date string, limit int) ([]Score, error) {
+	q := url.Values{}
+	q.Set("metric", metric)
+	q.Set("mode", mode)
+	if mode == ModeDaily {
+		q.Set("date", date)
+	}
+	q.Set("limit", strconv.Itoa(limit))
+	resp, err := c.httpClient.Get(c.baseURL + scoresPath + "?" + q.Encode())
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := ioutil.ReadAll(resp.Body)
+		return nil, fmt.Errorf("leaderboard: failed to fetch scores (%d): %s", resp.StatusCode, bytes.TrimSpace(msg))
+	}
+	var scores []Score
+	if err := json.NewDecoder(resp.Body).Decode(&scores); err != nil {
+		return nil, err
+	}
+	return scores, nil
+}
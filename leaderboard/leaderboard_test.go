@@ -0,0 +1,260 @@
+package leaderboard
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+type memoryStorage struct {
+	data map[string][]byte
+}
+
+func newMemoryStorage() *memoryStorage {
+	return &memoryStorage{data: map[string][]byte{}}
+}
+
+func (s *memoryStorage) Load(key string) ([]byte, error) {
+	d, ok := s.data[key]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return d, nil
+}
+
+func (s *memoryStorage) Save(key string, data []byte) error {
+	s.data[key] = data
+	return nil
+}
+
+// fakeServer records the submitted scores. It fails while down is set, and
+// rejects the scores named "cheater".
+type fakeServer struct {
+	m      sync.Mutex
+	down   bool
+	scores []Score
+}
+
+func (f *fakeServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.m.Lock()
+	defer f.m.Unlock()
+	if r.URL.Path != scoresPath {
+		http.NotFound(w, r)
+		return
+	}
+	if f.down {
+		http.Error(w, "down", http.StatusServiceUnavailable)
+		return
+	}
+	switch r.Method {
+	case http.MethodPost:
+		var s Score
+		if err := json.NewDecoder(r.Body).Decode(&s); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if s.Name == "cheater" {
+			http.Error(w, "invalid replay", http.StatusBadRequest)
+			return
+		}
+		f.scores = append(f.scores, s)
+	case http.MethodGet:
+		q := r.URL.Query()
+		var top []Score
+		for _, s := range f.scores {
+			if s.Metric == q.Get("metric") && s.Mode == q.Get("mode") && s.Date == q.Get("date") {
+				s.Replay = nil
+				top = append(top, s)
+			}
+		}
+		json.NewEncoder(w).Encode(top)
+	}
+}
+
+func (f *fakeServer) names() []string {
+	f.m.Lock()
+	defer f.m.Unlock()
+	var names []string
+	for _, s := range f.scores {
+		names = append(names, s.Name)
+	}
+	return names
+}
+
+func (f *fakeServer) setDown(down bool) {
+	f.m.Lock()
+	defer f.m.Unlock()
+	f.down = down
+}
+
+func newScore(name string) Score {
+	return Score{
+		Name:   name,
+		Metric: MetricHeight,
+		Score:  100,
+		Mode:   ModeNormal,
+		Time:   time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func equalNames(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestSubmitAndTop(t *testing.T) {
+	f := &fakeServer{}
+	srv := httptest.NewServer(f)
+	defer srv.Close()
+
+	c := NewClient(srv.URL, nil, newMemoryStorage())
+	daily := newScore("BBB")
+	daily.Mode = ModeDaily
+	daily.Date = "2020-01-02"
+	if err := c.Submit(newScore("AAA"), daily); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := f.names(), []string{"AAA", "BBB"}; !equalNames(got, want) {
+		t.Errorf("submitted %v, want %v", got, want)
+	}
+
+	top, err := c.Top(MetricHeight, ModeDaily, "2020-01-02", 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(top) != 1 || top[0].Name != "BBB" || top[0].Date != "2020-01-02" {
+		t.Errorf("Top returned %+v, want the daily score", top)
+	}
+}
+
+func TestSubmitQueuesWhileOffline(t *testing.T) {
+	f := &fakeServer{down: true}
+	srv := httptest.NewServer(f)
+	defer srv.Close()
+
+	st := newMemoryStorage()
+	c := NewClient(srv.URL, nil, st)
+	if err := c.Submit(newScore("AAA")); err == nil {
+		t.Error("Submit succeeded while the server is down")
+	}
+	if err := c.Submit(newScore("BBB")); err == nil {
+		t.Error("Submit succeeded while the server is down")
+	}
+	if n := c.QueueLen(); n != 2 {
+		t.Errorf("QueueLen() = %d, want 2", n)
+	}
+
+	// The queue survives a new session.
+	c = NewClient(srv.URL, nil, st)
+	if n := c.QueueLen(); n != 2 {
+		t.Errorf("QueueLen() after reload = %d, want 2", n)
+	}
+
+	f.setDown(false)
+	if err := c.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	if n := c.QueueLen(); n != 0 {
+		t.Errorf("QueueLen() after Flush = %d, want 0", n)
+	}
+	if got, want := f.names(), []string{"AAA", "BBB"}; !equalNames(got, want) {
+		t.Errorf("submitted %v, want %v in order", got, want)
+	}
+}
+
+func TestSubmitRetriesQueuedFirst(t *testing.T) {
+	f := &fakeServer{down: true}
+	srv := httptest.NewServer(f)
+	defer srv.Close()
+
+	c := NewClient(srv.URL, nil, newMemoryStorage())
+	c.Submit(newScore("AAA"))
+	f.setDown(false)
+	if err := c.Submit(newScore("BBB")); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := f.names(), []string{"AAA", "BBB"}; !equalNames(got, want) {
+		t.Errorf("submitted %v, want %v", got, want)
+	}
+}
+
+func TestRejectedScoreIsDropped(t *testing.T) {
+	f := &fakeServer{}
+	srv := httptest.NewServer(f)
+	defer srv.Close()
+
+	c := NewClient(srv.URL, nil, newMemoryStorage())
+	err := c.Submit(newScore("cheater"), newScore("AAA"))
+	var r *RejectedError
+	if !errors.As(err, &r) || r.StatusCode != http.StatusBadRequest {
+		t.Errorf("Submit returned %v, want a RejectedError", err)
+	}
+	if n := c.QueueLen(); n != 0 {
+		t.Errorf("QueueLen() = %d, want 0 since rejected scores are not retried", n)
+	}
+	if got, want := f.names(), []string{"AAA"}; !equalNames(got, want) {
+		t.Errorf("submitted %v, want %v", got, want)
+	}
+}
+
+func TestQueueIsBounded(t *testing.T) {
+	f := &fakeServer{down: true}
+	srv := httptest.NewServer(f)
+	defer srv.Close()
+
+	c := NewClient(srv.URL, nil, nil)
+	for i := 0; i < maxQueueLen+10; i++ {
+		c.Submit(newScore("AAA"))
+	}
+	if n := c.QueueLen(); n != maxQueueLen {
+		t.Errorf("QueueLen() = %d, want %d", n, maxQueueLen)
+	}
+}
+
+func TestQueueLenDuringSubmit(t *testing.T) {
+	arrived := make(chan struct{})
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		arrived <- struct{}{}
+		<-release
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL, nil, nil)
+	done := make(chan error)
+	go func() {
+		done <- c.Submit(newScore("AAA"))
+	}()
+	<-arrived
+	// QueueLen must not wait for the server while the score is posted.
+	n := make(chan int)
+	go func() {
+		n <- c.QueueLen()
+	}()
+	select {
+	case got := <-n:
+		if got != 1 {
+			t.Errorf("QueueLen() while posting = %d, want 1", got)
+		}
+	case <-time.After(time.Second):
+		t.Error("QueueLen waited for the server")
+	}
+	close(release)
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	if got := c.QueueLen(); got != 0 {
+		t.Errorf("QueueLen() after Submit = %d, want 0", got)
+	}
+}
@@ -1,16 +1,36 @@
 package com.hiroebe.osushi
 
 import androidx.appcompat.app.AppCompatActivity
+import android.content.Context
+import android.content.SharedPreferences
 import android.os.Bundle
+import java.util.Locale
 
 import go.Seq
 
 import com.hiroebe.osushi.mobile.EbitenView
+import com.hiroebe.osushi.mobile.KeyValueStore
+import com.hiroebe.osushi.mobile.Mobile
+
+class PreferencesStore(private val prefs: SharedPreferences) : KeyValueStore {
+    override fun get(key: String): String {
+        return prefs.getString(key, "") ?: ""
+    }
+
+    override fun set(key: String, value: String) {
+        prefs.edit().putString(key, value).apply()
+    }
+}
 
 class MainActivity : AppCompatActivity() {
 
     override fun onCreate(savedInstanceState: Bundle?) {
         super.onCreate(savedInstanceState)
+        Mobile.setKeyValueStore(PreferencesStore(getSharedPreferences("osushi", Context.MODE_PRIVATE)))
+        Mobile.setLocale(Locale.getDefault().toLanguageTag())
+        if (BuildConfig.LEADERBOARD_URL.isNotEmpty()) {
+            Mobile.setLeaderboardURL(BuildConfig.LEADERBOARD_URL)
+        }
         setContentView(R.layout.activity_main)
         Seq.setContext(applicationContext)
     }
@@ -2,6 +2,7 @@ package mobile
 
 import (
 	"log"
+	"sync"
 
 	"github.com/hajimehoshi/ebiten/mobile"
 	"github.com/hiroebe/osushi/game"
@@ -9,14 +10,76 @@ import (
 
 //go:generate env GO111MODULE=off ebitenmobile bind -target android -javapkg com.hiroebe.osushi -o ./android/osushi/osushi.aar .
 
+var theGame *game.Game
+
 func init() {
-	g, err := game.NewGame()
+	g, err := game.NewGame(&hostStorage{})
 	if err != nil {
 		log.Fatal(err)
 	}
+	theGame = g
 	mobile.SetGame(g)
 }
 
+// KeyValueStore is a persistent store provided by the host app, such as
+// SharedPreferences on Android. Get returns an empty string for a missing key.
+type KeyValueStore interface {
+	Get(key string) string
+	Set(key, value string)
+}
+
+var (
+	store   KeyValueStore
+	storeMu sync.Mutex
+)
+
+// SetKeyValueStore registers the store where the game keeps records and
+// settings. It must be called before the game view starts.
+func SetKeyValueStore(s KeyValueStore) {
+	storeMu.Lock()
+	defer storeMu.Unlock()
+	store = s
+}
+
+// SetLocale tells the game the locale of the device, such as "ja-JP". It must
+// be called before the game view starts.
+func SetLocale(locale string) {
+	game.SetSystemLocale(locale)
+}
+
+// SetLeaderboardURL makes the game use the online leaderboard at url. It must
+// be called before the game view starts.
+func SetLeaderboardURL(url string) {
+	theGame.SetLeaderboardURL(url)
+}
+
+// hostStorage is a game.Storage backed by the KeyValueStore of the host. It
+// keeps nothing until the host registers its store.
+type hostStorage struct{}
+
+func (*hostStorage) Load(key string) ([]byte, error) {
+	storeMu.Lock()
+	defer storeMu.Unlock()
+	if store == nil {
+		return nil, game.ErrNotFound
+	}
+	v := store.Get(key)
+	if v == "" {
+		return nil, game.ErrNotFound
+	}
+	return []byte(v), nil
+}
+
+func (*hostStorage) Save(key string, data []byte) error {
+	storeMu.Lock()
+	defer storeMu.Unlock()
+	if store == nil {
+		return nil
+	}
+	store.Set(key, string(data))
+	return nil
+}
+
 // Dummy is a dummy exported function.
 //
 // gomobile doesn't compile a package that doesn't include any exported function.
@@ -0,0 +1,180 @@
+package physics
+
+import "math"
+
+const (
+	MinV     = 2
+	Gravity  = 0.05
+	Friction = 0.02
+
+	DiveGravityScale = 3
+
+	// RotationSpeed is the angle in radians the body rotates by in a tick
+	// while the rotate input is held.
+	RotationSpeed = 2 * math.Pi / 48
+	// FlipTolerance is how far the body may be off the slope for a clean
+	// landing.
+	FlipTolerance = math.Pi / 6
+	// SloppyLandingScale is the speed kept after landing off a full flip.
+	SloppyLandingScale = 0.5
+	TrickPointsPerFlip = 100
+)
+
+// Input is the player's input in a tick.
+type Input struct {
+	// Dive is how deep the dive input is pressed in [0, 1].
+	Dive float64 `json:"d,omitempty"`
+	// Jump is true in the tick when the jump input is released.
+	Jump bool `json:"j,omitempty"`
+	// Rotate spins the body backwards while it is in the air.
+	Rotate bool `json:"r,omitempty"`
+}
+
+type JumpScore struct {
+	Height float64
+	Length float64
+	// Ticks is the airtime in ticks.
+	Ticks int
+	// Alignment is how well the velocity was aligned with the slope on
+	// landing. It is 1 for a perfect landing and negative when the body
+	// hits the slope head-on.
+	Alignment float64
+	// Flips is the number of full rotations, and Trick is the points they
+	// earned, which are 0 for a sloppy landing.
+	Flips int
+	Trick int
+}
+
+// Body is the state of the player.
+type Body struct {
+	X, Y      float64
+	VX, VY    float64
+	IsJumping bool
+
+	JumpHeight float64
+	JumpLength float64
+	JumpTicks  int
+	JumpStartX float64
+
+	// Rotation is the angle in radians the body has rotated by in the
+	// current jump, counterclockwise relative to its velocity. Tricks is the
+	// total points of the tricks in the run.
+	Rotation float64
+	Tricks   int
+
+	// Jumped and Landed are true only in the tick when the body jumps or
+	// lands, and LastJump is the score of the jump which ended there.
+	Jumped   bool
+	Landed   bool
+	LastJump JumpScore
+}
+
+// Step advances the body by a tick. gy and grad are the height and the
+// gradient of the ground under the body at the beginning of the tick.
+func (b *Body) Step(in Input, gy, grad float64) {
+	b.Jumped = false
+	b.Landed = false
+	obl := math.Sqrt(1 + grad*grad)
+
+	b.updateV(in, grad, obl)
+
+	b.X += b.VX
+	b.Y += b.VY
+
+	if !b.IsJumping || b.Y < gy {
+		b.Y = gy
+		if b.IsJumping {
+			b.land(grad, obl)
+		}
+	}
+
+	b.updateJumpScore()
+}
+
+func (b *Body) updateV(in Input, grad, obl float64) {
+	g := -Gravity
+	if in.Dive > 0 {
+		g *= 1 + (DiveGravityScale-1)*in.Dive
+	}
+	if b.IsJumping {
+		b.VY += g
+		if in.Rotate {
+			b.Rotation += RotationSpeed
+		}
+		return
+	}
+
+	v := math.Sqrt(b.VX*b.VX+b.VY*b.VY) + g*grad/obl - Friction/obl
+	if v < MinV {
+		v = MinV
+	}
+	b.VX = v / obl
+	b.VY = v * grad / obl
+
+	if in.Jump {
+		b.jump(grad, obl)
+		return
+	}
+}
+
+func (b *Body) jump(grad, obl float64) {
+	b.IsJumping = true
+	b.Jumped = true
+	b.JumpStartX = b.X
+	b.Rotation = 0
+
+	b.VY += Gravity / obl
+}
+
+func (b *Body) land(grad, obl float64) {
+	b.IsJumping = false
+	b.Landed = true
+
+	dv := (b.VX + b.VY*grad) / obl
+	b.LastJump = JumpScore{
+		Height:    b.JumpHeight,
+		Length:    b.JumpLength,
+		Ticks:     b.JumpTicks,
+		Alignment: dv / math.Sqrt(b.VX*b.VX+b.VY*b.VY),
+	}
+	flips := math.Round(b.Rotation / (2 * math.Pi))
+	// The landing is clean if the body, which is drawn along the velocity
+	// and rotated from it, is aligned with the slope.
+	off := math.Atan(b.VY/b.VX) + b.Rotation - math.Atan(grad)
+	clean := math.Abs(math.Remainder(off, 2*math.Pi)) <= FlipTolerance
+	rotated := b.Rotation != 0
+	b.Rotation = 0
+	if dv < 0 {
+		b.VX = 0
+		b.VY = 0
+		return
+	}
+	b.LastJump.Flips = int(flips)
+	if clean {
+		b.LastJump.Trick = int(flips) * TrickPointsPerFlip
+		b.Tricks += b.LastJump.Trick
+	} else if rotated {
+		// Only a landing off a flip is sloppy, and an ordinary one keeps
+		// its speed.
+		dv *= SloppyLandingScale
+	}
+	if b.JumpLength > MinMountainWidth {
+		dv *= 1.1
+	}
+	b.VX = dv / obl
+	b.VY = dv * grad / obl
+}
+
+func (b *Body) updateJumpScore() {
+	if !b.IsJumping {
+		b.JumpHeight = 0
+		b.JumpLength = 0
+		b.JumpTicks = 0
+		return
+	}
+	b.JumpTicks++
+	b.JumpLength = b.X - b.JumpStartX
+	if b.Y > b.JumpHeight {
+		b.JumpHeight = b.Y
+	}
+}
@@ -0,0 +1,47 @@
+package physics
+
+import (
+	"math"
+	"testing"
+)
+
+func TestLandingTrick(t *testing.T) {
+	tests := []struct {
+		name     string
+		rotation float64
+		grad     float64
+		trick    int
+	}{
+		{"flip along the slope", 2 * math.Pi, -1, TrickPointsPerFlip},
+		{"flip slightly off the slope", 2*math.Pi + FlipTolerance/2, -1, TrickPointsPerFlip},
+		{"flip off the slope", 2 * math.Pi, 0.5, 0},
+		{"half flip", math.Pi, -1, 0},
+	}
+	for _, tt := range tests {
+		// The body falls at 45 degrees down onto the slope.
+		b := &Body{X: 100, Y: 10, VX: 2, VY: -2, IsJumping: true, Rotation: tt.rotation}
+		b.Step(Input{}, 20, tt.grad)
+		if !b.Landed {
+			t.Fatalf("%s: the body didn't land", tt.name)
+		}
+		if b.LastJump.Trick != tt.trick {
+			t.Errorf("%s: Trick = %d, want %d", tt.name, b.LastJump.Trick, tt.trick)
+		}
+	}
+}
+
+func TestLandingWithoutRotationKeepsSpeed(t *testing.T) {
+	// The body falls at 45 degrees down onto flat ground, which is off the
+	// body, but it is not sloppy without rotation.
+	b := &Body{X: 100, Y: 10, VX: 2, VY: -2, IsJumping: true}
+	b.Step(Input{}, 20, 0)
+	if !b.Landed {
+		t.Fatal("the body didn't land")
+	}
+	if b.VX != 2 || b.VY != 0 {
+		t.Errorf("velocity after landing = (%v, %v), want (2, 0)", b.VX, b.VY)
+	}
+	if b.LastJump.Trick != 0 {
+		t.Errorf("Trick = %d, want 0", b.LastJump.Trick)
+	}
+}
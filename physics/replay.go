@@ -0,0 +1,84 @@
+package physics
+
+import "errors"
+
+// Frame is an input which differs from the one of the previous tick.
+type Frame struct {
+	Tick int `json:"t"`
+	Input
+}
+
+// Replay is the inputs of a run from its start. Only the changes of the input
+// are kept since it stays the same for most ticks.
+type Replay struct {
+	Seed   int64   `json:"seed"`
+	Ticks  int     `json:"ticks"`
+	Frames []Frame `json:"frames"`
+
+	last Input
+}
+
+func NewReplay(seed int64) *Replay {
+	return &Replay{Seed: seed}
+}
+
+// Record appends the input of the next tick.
+func (r *Replay) Record(in Input) {
+	if in != r.last {
+		r.Frames = append(r.Frames, Frame{Tick: r.Ticks, Input: in})
+		r.last = in
+	}
+	r.Ticks++
+}
+
+// Copy returns a snapshot of r, which is not changed by the later records.
+func (r *Replay) Copy() *Replay {
+	c := *r
+	c.Frames = append([]Frame(nil), r.Frames...)
+	return &c
+}
+
+// Result is what a simulated run achieved.
+type Result struct {
+	Jumps    []JumpScore
+	Distance float64
+}
+
+var errInvalidReplay = errors.New("physics: invalid replay")
+
+// Simulate runs the replay from the start with the same physics as the game
+// and returns every jump and the distance at the end.
+func Simulate(r *Replay, maxTicks int) (*Result, error) {
+	if r.Ticks < 0 || r.Ticks > maxTicks {
+		return nil, errInvalidReplay
+	}
+	t := NewTerrain(r.Seed)
+	b := &Body{}
+	res := &Result{}
+	in := Input{}
+	next := 0
+	for tick := 0; tick < r.Ticks; tick++ {
+		if next < len(r.Frames) {
+			f := r.Frames[next]
+			if f.Tick < tick || f.Dive < 0 || f.Dive > 1 {
+				return nil, errInvalidReplay
+			}
+			if f.Tick == tick {
+				in = f.Input
+				next++
+			}
+		}
+		t.Extend(b.X)
+		gy, grad := t.At(b.X)
+		b.Step(in, gy, grad)
+		t.Trim(b.X)
+		if b.Landed {
+			res.Jumps = append(res.Jumps, b.LastJump)
+		}
+	}
+	if next != len(r.Frames) {
+		return nil, errInvalidReplay
+	}
+	res.Distance = b.X
+	return res, nil
+}
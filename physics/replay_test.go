@@ -0,0 +1,118 @@
+package physics
+
+import (
+	"reflect"
+	"testing"
+)
+
+const testTicks = 60 * 60
+
+// testInput jumps every two seconds and rotates and dives in some of the
+// jumps, so that a run has jumps of every kind.
+func testInput(tick int) Input {
+	var in Input
+	switch t := tick % 120; {
+	case t == 60:
+		in.Jump = true
+	case t > 60 && t < 84 && tick%360 < 120:
+		in.Rotate = true
+	case t > 90 && tick%240 < 120:
+		in.Dive = 0.5
+	}
+	return in
+}
+
+// play runs the game as the client does, which extends the terrain ahead of
+// the screen and trims it behind, and records the replay.
+func play(seed int64, ticks int) (*Replay, *Result) {
+	r := NewReplay(seed)
+	t := NewTerrain(seed)
+	b := &Body{}
+	res := &Result{}
+	for tick := 0; tick < ticks; tick++ {
+		in := testInput(tick)
+		r.Record(in)
+		gy, grad := t.At(b.X)
+		b.Step(in, gy, grad)
+		if b.Landed {
+			res.Jumps = append(res.Jumps, b.LastJump)
+		}
+		t.Trim(b.X - 100)
+		t.Extend(b.X + 2000)
+	}
+	res.Distance = b.X
+	return r, res
+}
+
+func TestSimulateReproducesRun(t *testing.T) {
+	r, want := play(42, testTicks)
+	if len(want.Jumps) == 0 {
+		t.Fatal("the run has no jumps")
+	}
+	got, err := Simulate(r, testTicks)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Simulate = %+v, want %+v", got, want)
+	}
+}
+
+func TestRecordKeepsChanges(t *testing.T) {
+	r := NewReplay(1)
+	r.Record(Input{})
+	r.Record(Input{Dive: 1})
+	r.Record(Input{Dive: 1})
+	r.Record(Input{})
+	want := []Frame{{Tick: 1, Input: Input{Dive: 1}}, {Tick: 3}}
+	if r.Ticks != 4 || !reflect.DeepEqual(r.Frames, want) {
+		t.Errorf("Record made %d ticks of %+v, want 4 ticks of %+v", r.Ticks, r.Frames, want)
+	}
+	c := r.Copy()
+	r.Record(Input{Jump: true})
+	if c.Ticks != 4 || len(c.Frames) != 2 {
+		t.Errorf("Copy was changed by Record: %+v", c)
+	}
+}
+
+func TestSimulateTamperedReplay(t *testing.T) {
+	r, want := play(42, testTicks)
+	// Dropping a jump changes the run.
+	c := r.Copy()
+	for i, f := range c.Frames {
+		if f.Jump {
+			c.Frames[i].Jump = false
+			break
+		}
+	}
+	got, err := Simulate(c, testTicks)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reflect.DeepEqual(got, want) {
+		t.Error("Simulate of a tampered replay reproduced the run")
+	}
+}
+
+func TestSimulateInvalidReplay(t *testing.T) {
+	r, _ := play(42, testTicks)
+	tests := []struct {
+		name   string
+		tamper func(r *Replay)
+	}{
+		{"too long", func(r *Replay) { r.Ticks = testTicks + 1 }},
+		{"negative ticks", func(r *Replay) { r.Ticks = -1 }},
+		{"unordered frames", func(r *Replay) { r.Frames[1].Tick = r.Frames[0].Tick }},
+		{"dive out of range", func(r *Replay) { r.Frames[0].Dive = 2 }},
+		{"frame after the end", func(r *Replay) {
+			r.Frames = append(r.Frames, Frame{Tick: r.Ticks, Input: Input{Jump: true}})
+		}},
+	}
+	for _, tt := range tests {
+		c := r.Copy()
+		tt.tamper(c)
+		if _, err := Simulate(c, testTicks); err == nil {
+			t.Errorf("%s: Simulate succeeded", tt.name)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+// Package physics simulates the player and the ground of the game. It doesn't
+// depend on Ebiten, so the server can re-simulate replays with exactly the same
+// code as the game.
+package physics
+
+import (
+	"math"
+	"math/rand"
+)
+
+const (
+	GroundY           = 16
+	MinMountainWidth  = 200
+	MinMountainHeight = 100
+	MaxMountainWidth  = 500
+	MaxMountainHeight = 300
+)
+
+type Mountain struct {
+	startX        float64
+	width, height float64
+}
+
+func NewRandomMountain(r *rand.Rand, startX float64) *Mountain {
+	width := MinMountainWidth + r.Float64()*(MaxMountainWidth-MinMountainWidth)
+	height := MinMountainHeight + r.Float64()*(MaxMountainHeight-MinMountainHeight)
+	return &Mountain{startX: startX, width: width, height: height}
+}
+
+func (m *Mountain) StartX() float64 {
+	return m.startX
+}
+
+func (m *Mountain) EndX() float64 {
+	return m.startX + m.width
+}
+
+func (m *Mountain) TopX() float64 {
+	return m.startX + m.width/2
+}
+
+func (m *Mountain) Width() float64 {
+	return m.width
+}
+
+func (m *Mountain) Height() float64 {
+	return m.height
+}
+
+func (m *Mountain) At(x float64) (y, grad float64) {
+	x -= m.StartX()
+	y = GroundY + m.Height()/2*(1-math.Cos(2*math.Pi/m.Width()*x))
+	grad = m.Height() / m.Width() * math.Pi * math.Sin(2*math.Pi/m.Width()*x)
+	return y, grad
+}
+
+// Terrain is the course made of mountains generated from a seed. The same seed
+// always gives the same course.
+type Terrain struct {
+	mountains []*Mountain
+	seed      int64
+	rand      *rand.Rand
+}
+
+func NewTerrain(seed int64) *Terrain {
+	t := &Terrain{
+		mountains: make([]*Mountain, 0, 64),
+		seed:      seed,
+		rand:      rand.New(rand.NewSource(seed)),
+	}
+	m := &Mountain{startX: -MaxMountainWidth / 2, width: MaxMountainWidth, height: MaxMountainHeight}
+	t.mountains = append(t.mountains, m)
+	return t
+}
+
+func (t *Terrain) Seed() int64 {
+	return t.seed
+}
+
+func (t *Terrain) Mountains() []*Mountain {
+	return t.mountains
+}
+
+// Extend generates mountains until they reach x.
+func (t *Terrain) Extend(x float64) {
+	for {
+		lastX := t.mountains[len(t.mountains)-1].EndX()
+		if lastX >= x {
+			return
+		}
+		t.mountains = append(t.mountains, NewRandomMountain(t.rand, lastX))
+	}
+}
+
+// Trim drops the first mountain if it ends before x. It drops at most one
+// mountain in a call, which is enough when it is called on every tick.
+func (t *Terrain) Trim(x float64) {
+	if len(t.mountains) > 1 && t.mountains[0].EndX() < x {
+		copy(t.mountains, t.mountains[1:])
+		t.mountains = t.mountains[:len(t.mountains)-1]
+	}
+}
+
+// At returns the height and the gradient of the ground at x, which must be
+// within the mountains extended and not trimmed yet. Otherwise it returns 0.
+func (t *Terrain) At(x float64) (y, grad float64) {
+	for _, m := range t.mountains {
+		if x >= m.StartX() && x <= m.EndX() {
+			return m.At(x)
+		}
+	}
+	return 0, 0
+}
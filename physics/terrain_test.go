@@ -0,0 +1,47 @@
+package physics
+
+import "testing"
+
+func TestTerrainIsDeterministic(t *testing.T) {
+	// One terrain is extended far at once, and the other bit by bit with the
+	// passed mountains trimmed as the game does. They must be the same course.
+	a := NewTerrain(42)
+	a.Extend(10000)
+	b := NewTerrain(42)
+	for x := 0.0; x < 10000; x += 7 {
+		b.Extend(x + 800)
+		b.Trim(x - 100)
+		ya, ga := a.At(x)
+		yb, gb := b.At(x)
+		if ya != yb || ga != gb {
+			t.Fatalf("At(%v) = %v, %v and %v, %v", x, ya, ga, yb, gb)
+		}
+	}
+}
+
+func TestTerrainAtDoesNotExtend(t *testing.T) {
+	tr := NewTerrain(1)
+	n := len(tr.Mountains())
+	if y, grad := tr.At(10000); y != 0 || grad != 0 {
+		t.Errorf("At beyond the extended mountains = %v, %v, want 0, 0", y, grad)
+	}
+	if len(tr.Mountains()) != n {
+		t.Errorf("At extended the mountains from %d to %d", n, len(tr.Mountains()))
+	}
+}
+
+func TestTrimDropsOneMountain(t *testing.T) {
+	tr := NewTerrain(1)
+	tr.Extend(5000)
+	n := len(tr.Mountains())
+	tr.Trim(5000)
+	if got := len(tr.Mountains()); got != n-1 {
+		t.Errorf("Trim left %d mountains, want %d", got, n-1)
+	}
+	for i := 0; i < n; i++ {
+		tr.Trim(1e9)
+	}
+	if got := len(tr.Mountains()); got != 1 {
+		t.Errorf("Trim left %d mountains, want the last one", got)
+	}
+}